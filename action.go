@@ -0,0 +1,59 @@
+package ted
+
+import (
+	"context"
+	"time"
+)
+
+// Chat actions which can be sent with a SendChatActionRequest.
+const (
+	ChatActionTyping          = "typing"
+	ChatActionUploadPhoto     = "upload_photo"
+	ChatActionRecordVideo     = "record_video"
+	ChatActionUploadVideo     = "upload_video"
+	ChatActionRecordVoice     = "record_voice"
+	ChatActionUploadVoice     = "upload_voice"
+	ChatActionUploadDocument  = "upload_document"
+	ChatActionFindLocation    = "find_location"
+	ChatActionRecordVideoNote = "record_video_note"
+	ChatActionUploadVideoNote = "upload_video_note"
+)
+
+// chatActionInterval is how often KeepChatAction sends its chat action again,
+// slightly less than the 5 seconds for which Telegram clients show it.
+var chatActionInterval = 4500 * time.Millisecond
+
+// KeepChatAction makes req immediately, then makes it again every few seconds
+// so that the chat action stays visible until ctx is done or the returned
+// stop function is called, whichever happens first. Errors from individual
+// requests are ignored. stop waits for any request in progress to complete,
+// so no more chat actions are sent once it returns.
+//
+// A typical use is to show that the bot is typing while a handler is busy:
+//
+//	stop := bot.KeepChatAction(ctx, ted.SendChatActionRequest{
+//		ChatID: chatID,
+//		Action: ted.ChatActionTyping,
+//	})
+//	defer stop()
+func (b Bot) KeepChatAction(ctx context.Context, req SendChatActionRequest) (stop func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(chatActionInterval)
+		defer ticker.Stop()
+		for {
+			b.DoContext(ctx, req)
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
@@ -0,0 +1,60 @@
+package ted
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBot_KeepChatAction(t *testing.T) {
+	defer func(interval time.Duration) {
+		chatActionInterval = interval
+	}(chatActionInterval)
+	chatActionInterval = 10 * time.Millisecond
+
+	var mu sync.Mutex
+	var actions []SendChatActionRequest
+	sent := make(chan struct{}, 100)
+	bot := Bot{
+		HTTPClient: clientFunc(func(req *http.Request) (*http.Response, error) {
+			assert.True(t, strings.HasSuffix(req.URL.Path, "/sendChatAction"))
+			var action SendChatActionRequest
+			assert.NoError(t, json.NewDecoder(req.Body).Decode(&action))
+			mu.Lock()
+			actions = append(actions, action)
+			mu.Unlock()
+			sent <- struct{}{}
+			return &http.Response{Body: ioutil.NopCloser(strings.NewReader(`{"ok":true,"result":true}`))}, nil
+		}),
+	}
+
+	t.Run("repeats until stopped", func(t *testing.T) {
+		stop := bot.KeepChatAction(context.Background(), SendChatActionRequest{ChatID: 123, Action: ChatActionTyping})
+		for i := 0; i < 3; i++ {
+			<-sent
+		}
+		stop()
+		mu.Lock()
+		n := len(actions)
+		mu.Unlock()
+		time.Sleep(5 * chatActionInterval)
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Len(t, actions, n)
+		assert.Equal(t, ChatActionTyping, actions[0].Action)
+	})
+	t.Run("stops when context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		stop := bot.KeepChatAction(ctx, SendChatActionRequest{ChatID: 123, Action: ChatActionUploadPhoto})
+		<-sent
+		cancel()
+		stop()
+	})
+}
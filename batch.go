@@ -0,0 +1,134 @@
+package ted
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// BatchOptions configures how DoBatch makes requests.
+type BatchOptions struct {
+	// MaxConcurrency is the maximum number of requests which will be in
+	// progress at the same time. If zero, all requests may be made at the
+	// same time.
+	MaxConcurrency int
+
+	// Ordered makes requests to the same chat one at a time, in the order
+	// they were given, so that messages sent to a chat arrive in order.
+	// Requests are ordered by their ChatID field, so any request to a chat,
+	// such as an edit or a deletion, is ordered with the messages sent to
+	// it. Requests without a ChatID are not ordered.
+	Ordered bool
+}
+
+// BatchResult pairs a request made by DoBatch with its outcome.
+type BatchResult struct {
+	Request Request
+
+	// Response is the response to Request if it was successful.
+	Response Response
+
+	// Err is the error Request failed with, if any.
+	Err error
+}
+
+// DoBatch makes multiple requests concurrently and waits for all of them to
+// complete. A result is returned for every request, in the same order as
+// requests, so that the responses to successful requests are available even
+// when others fail.
+//
+// If any requests were unsuccessful, the returned error will be a MultiError
+// containing the error for each request, which is nil for requests which
+// succeeded. Requests which had not yet been made when ctx is done fail with
+// ctx.Err().
+func (b Bot) DoBatch(ctx context.Context, opts BatchOptions, requests ...Request) ([]BatchResult, error) {
+	results := make([]BatchResult, len(requests))
+	var sem chan struct{}
+	if opts.MaxConcurrency > 0 {
+		sem = make(chan struct{}, opts.MaxConcurrency)
+	}
+	var wg sync.WaitGroup
+	for _, queue := range batchQueues(requests, opts.Ordered) {
+		wg.Add(1)
+		go func(queue []int) {
+			defer wg.Done()
+			for _, i := range queue {
+				results[i] = b.doBatched(ctx, sem, requests[i])
+			}
+		}(queue)
+	}
+	wg.Wait()
+	errs := make(MultiError, len(requests))
+	failed := false
+	for i, result := range results {
+		if result.Err != nil {
+			errs[i] = result.Err
+			failed = true
+		}
+	}
+	if failed {
+		return results, errs
+	}
+	return results, nil
+}
+
+// doBatched makes request once a slot in sem is available.
+func (b Bot) doBatched(ctx context.Context, sem chan struct{}, request Request) BatchResult {
+	result := BatchResult{Request: request}
+	if sem != nil {
+		select {
+		case sem <- struct{}{}:
+			defer func() { <-sem }()
+		case <-ctx.Done():
+			result.Err = ctx.Err()
+			return result
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		result.Err = err
+		return result
+	}
+	result.Response, result.Err = b.DoContext(ctx, request)
+	return result
+}
+
+// batchQueues groups the indices of requests into queues which are made
+// concurrently with each other. Requests in the same queue are made one at a
+// time. If ordered is true, requests to the same chat are placed in the same
+// queue, otherwise each request is placed in its own queue.
+func batchQueues(requests []Request, ordered bool) [][]int {
+	var queues [][]int
+	chats := make(map[string]int)
+	for i, request := range requests {
+		if ordered {
+			if chatID, ok := chatOf(request); ok {
+				key := fmt.Sprint(chatID)
+				if q, ok := chats[key]; ok {
+					queues[q] = append(queues[q], i)
+					continue
+				}
+				chats[key] = len(queues)
+			}
+		}
+		queues = append(queues, []int{i})
+	}
+	return queues
+}
+
+// chatOf returns the value of the ChatID field of request, if it has one
+// which is set.
+func chatOf(request Request) (interface{}, bool) {
+	v := reflect.ValueOf(request)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, false
+	}
+	field := v.FieldByName("ChatID")
+	if !field.IsValid() || field.IsZero() {
+		return nil, false
+	}
+	return field.Interface(), true
+}
@@ -0,0 +1,125 @@
+package ted
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// echoClient responds to sendMessage requests with the text of the message,
+// or an error if the text is "fail".
+func echoClient(delay time.Duration, record func(text string)) HTTPClient {
+	return clientFunc(func(req *http.Request) (*http.Response, error) {
+		var body struct {
+			Text string `json:"text"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			return nil, err
+		}
+		if record != nil {
+			record(body.Text)
+		}
+		time.Sleep(delay)
+		if body.Text == "fail" {
+			return &http.Response{Body: ioutil.NopCloser(strings.NewReader(`{"ok":false,"error_code":400,"description":"Bad Request"}`))}, nil
+		}
+		return &http.Response{Body: ioutil.NopCloser(strings.NewReader(`{"ok":true,"result":"` + body.Text + `"}`))}, nil
+	})
+}
+
+func TestBot_DoMulti(t *testing.T) {
+	t.Run("waits for all requests", func(t *testing.T) {
+		bot := Bot{HTTPClient: echoClient(10*time.Millisecond, nil)}
+		responses, err := bot.DoMulti(SendMessageRequest{Text: "a"}, SendMessageRequest{Text: "b"})
+		assert.NoError(t, err)
+		assert.Len(t, responses, 2)
+		assert.Equal(t, `"a"`, string(responses[0].Result))
+		assert.Equal(t, `"b"`, string(responses[1].Result))
+	})
+	t.Run("returns successful responses with errors", func(t *testing.T) {
+		bot := Bot{HTTPClient: echoClient(0, nil)}
+		responses, err := bot.DoMulti(SendMessageRequest{Text: "a"}, SendMessageRequest{Text: "fail"})
+		assert.IsType(t, MultiError{}, err)
+		errs := err.(MultiError)
+		assert.NoError(t, errs[0])
+		assert.Error(t, errs[1])
+		assert.Equal(t, `"a"`, string(responses[0].Result))
+	})
+}
+
+func TestBot_DoBatch(t *testing.T) {
+	t.Run("limits concurrency", func(t *testing.T) {
+		var inFlight, maxInFlight int32
+		client := clientFunc(func(req *http.Request) (*http.Response, error) {
+			n := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			for {
+				max := atomic.LoadInt32(&maxInFlight)
+				if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			return &http.Response{Body: ioutil.NopCloser(strings.NewReader(`{"ok":true}`))}, nil
+		})
+		bot := Bot{HTTPClient: client}
+		requests := make([]Request, 10)
+		for i := range requests {
+			requests[i] = SendMessageRequest{ChatID: i, Text: "text"}
+		}
+		results, err := bot.DoBatch(context.Background(), BatchOptions{MaxConcurrency: 3}, requests...)
+		assert.NoError(t, err)
+		assert.Len(t, results, 10)
+		assert.True(t, maxInFlight <= 3, "max in flight: %d", maxInFlight)
+	})
+	t.Run("keeps requests to the same chat in order", func(t *testing.T) {
+		var mu sync.Mutex
+		var sent []string
+		bot := Bot{HTTPClient: echoClient(5*time.Millisecond, func(text string) {
+			mu.Lock()
+			sent = append(sent, text)
+			mu.Unlock()
+		})}
+		var requests []Request
+		for _, text := range []string{"1", "2", "3", "4", "5"} {
+			requests = append(requests, SendMessageRequest{ChatID: 123, Text: text})
+		}
+		results, err := bot.DoBatch(context.Background(), BatchOptions{Ordered: true}, requests...)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1", "2", "3", "4", "5"}, sent)
+		for i, result := range results {
+			assert.Equal(t, requests[i], result.Request)
+		}
+	})
+	t.Run("fails requests not made before context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		bot := Bot{HTTPClient: clientFunc(func(req *http.Request) (*http.Response, error) {
+			cancel()
+			return &http.Response{Body: ioutil.NopCloser(strings.NewReader(`{"ok":true}`))}, nil
+		})}
+		results, err := bot.DoBatch(ctx, BatchOptions{Ordered: true}, SendMessageRequest{ChatID: 123}, SendMessageRequest{ChatID: 123})
+		assert.Error(t, err)
+		assert.NoError(t, results[0].Err)
+		assert.Equal(t, context.Canceled, results[1].Err)
+	})
+}
+
+func Test_batchQueues(t *testing.T) {
+	requests := []Request{
+		SendMessageRequest{ChatID: 123, Text: "Hello"},
+		EditMessageTextRequest{ChatID: 123, MessageID: 1, Text: "Hi"},
+		SendMessageRequest{ChatID: 456, Text: "Hello"},
+		DeleteMessageRequest{ChatID: 123, MessageID: 1},
+		AnswerCallbackQueryRequest{CallbackQueryID: "abc"},
+	}
+	assert.Equal(t, [][]int{{0, 1, 3}, {2}, {4}}, batchQueues(requests, true))
+	assert.Equal(t, [][]int{{0}, {1}, {2}, {3}, {4}}, batchQueues(requests, false))
+}
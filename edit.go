@@ -0,0 +1,42 @@
+package ted
+
+import (
+	"errors"
+)
+
+// ErrInvalidMessageTarget is returned when a request which edits a message
+// does not identify it using exactly one of ChatID and MessageID or
+// InlineMessageID.
+var ErrInvalidMessageTarget = errors.New("ted: exactly one of ChatID and MessageID or InlineMessageID must be specified")
+
+// messageTarget identifies the message a request applies to, either by its
+// chat and message identifiers or by its inline message identifier.
+type messageTarget struct {
+	ChatID          interface{} `json:"chat_id,omitempty"`
+	MessageID       int         `json:"message_id,omitempty"`
+	InlineMessageID string      `json:"inline_message_id,omitempty"`
+}
+
+// validate returns ErrInvalidMessageTarget unless exactly one way of
+// identifying the message is used.
+func (t messageTarget) validate() error {
+	if t.InlineMessageID != "" {
+		if t.ChatID != nil || t.MessageID != 0 {
+			return ErrInvalidMessageTarget
+		}
+		return nil
+	}
+	if t.ChatID == nil || t.MessageID == 0 {
+		return ErrInvalidMessageTarget
+	}
+	return nil
+}
+
+// params adds the parameters identifying the message to the parameters of a
+// request made using doUpload.
+func (t messageTarget) params(params map[string]interface{}) map[string]interface{} {
+	params["chat_id"] = t.ChatID
+	params["message_id"] = t.MessageID
+	params["inline_message_id"] = t.InlineMessageID
+	return params
+}
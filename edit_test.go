@@ -0,0 +1,59 @@
+package ted
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageTarget_validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		target messageTarget
+		valid  bool
+	}{
+		{"chat and message", messageTarget{ChatID: 123, MessageID: 456}, true},
+		{"inline message", messageTarget{InlineMessageID: "abc"}, true},
+		{"none", messageTarget{}, false},
+		{"chat without message", messageTarget{ChatID: 123}, false},
+		{"message without chat", messageTarget{MessageID: 456}, false},
+		{"both", messageTarget{ChatID: 123, MessageID: 456, InlineMessageID: "abc"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.target.validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, ErrInvalidMessageTarget, err)
+			}
+		})
+	}
+}
+
+func TestEditMessageCaptionRequest_invalidTarget(t *testing.T) {
+	client := &httpClient{}
+	bot := Bot{HTTPClient: client}
+	_, err := bot.EditMessageCaption(context.Background(), EditMessageCaptionRequest{ChatID: 123, InlineMessageID: "abc", Caption: "Caption"})
+	assert.Equal(t, ErrInvalidMessageTarget, err)
+	assert.Empty(t, client.requests)
+}
+
+func TestEditMessageLiveLocationRequest_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(EditMessageLiveLocationRequest{
+		InlineMessageID: "abc",
+		Latitude:        1.2834,
+		Longitude:       103.8607,
+		Heading:         90,
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"inline_message_id":"abc","latitude":1.2834,"longitude":103.8607,"heading":90}`, string(data))
+}
+
+func TestStopMessageLiveLocationRequest_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(StopMessageLiveLocationRequest{ChatID: 123, MessageID: 456})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"chat_id":123,"message_id":456}`, string(data))
+}
@@ -0,0 +1,48 @@
+package ted
+
+import (
+	"strings"
+)
+
+// DefaultEndpointURL is the URL of the Bot API server used when an Endpoint
+// does not specify one.
+const DefaultEndpointURL = "https://api.telegram.org"
+
+// Endpoint configures the Bot API server which requests are made to.
+type Endpoint struct {
+	// URL of the Bot API server, such as http://localhost:8081 for a
+	// self-hosted server. If empty, DefaultEndpointURL is used.
+	URL string
+
+	// Test makes requests to Telegram's test environment instead of the
+	// production environment.
+	Test bool
+
+	// Local should be set when URL refers to a Bot API server running in
+	// --local mode. Files of any size can then be downloaded, and are read
+	// directly from the file system when getFile returns an absolute file
+	// path.
+	Local bool
+}
+
+// methodURL returns the URL for calling method.
+func (e Endpoint) methodURL(token, method string) string {
+	return e.url("bot"+token, method)
+}
+
+// fileURL returns the URL for downloading the file at path.
+func (e Endpoint) fileURL(token, path string) string {
+	return e.url("file/bot"+token, path)
+}
+
+func (e Endpoint) url(prefix, path string) string {
+	base := e.URL
+	if base == "" {
+		base = DefaultEndpointURL
+	}
+	u := strings.TrimSuffix(base, "/") + "/" + prefix
+	if e.Test {
+		u += "/test"
+	}
+	return u + "/" + path
+}
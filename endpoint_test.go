@@ -0,0 +1,41 @@
+package ted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpoint(t *testing.T) {
+	tests := []struct {
+		name      string
+		endpoint  Endpoint
+		methodURL string
+		fileURL   string
+	}{
+		{
+			name:      "default",
+			endpoint:  Endpoint{},
+			methodURL: "https://api.telegram.org/botTOKEN/getMe",
+			fileURL:   "https://api.telegram.org/file/botTOKEN/photos/file_0.jpg",
+		},
+		{
+			name:      "self-hosted",
+			endpoint:  Endpoint{URL: "http://localhost:8081/"},
+			methodURL: "http://localhost:8081/botTOKEN/getMe",
+			fileURL:   "http://localhost:8081/file/botTOKEN/photos/file_0.jpg",
+		},
+		{
+			name:      "test environment",
+			endpoint:  Endpoint{Test: true},
+			methodURL: "https://api.telegram.org/botTOKEN/test/getMe",
+			fileURL:   "https://api.telegram.org/file/botTOKEN/test/photos/file_0.jpg",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.methodURL, tt.endpoint.methodURL("TOKEN", "getMe"))
+			assert.Equal(t, tt.fileURL, tt.endpoint.fileURL("TOKEN", "photos/file_0.jpg"))
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package ted
+
+import (
+	"errors"
+	"strings"
+)
+
+// Errors which an APIError can be matched against using errors.Is.
+var (
+	ErrBotBlocked            = errors.New("ted: bot was blocked by the user")
+	ErrChatNotFound          = errors.New("ted: chat not found")
+	ErrMessageToEditNotFound = errors.New("ted: message to edit not found")
+	ErrMessageCantBeDeleted  = errors.New("ted: message can't be deleted")
+	ErrMessageNotModified    = errors.New("ted: message is not modified")
+	ErrTooManyRequests       = errors.New("ted: too many requests")
+	ErrChatMigrated          = errors.New("ted: group chat was upgraded to a supergroup chat")
+)
+
+// errorDescriptions contains the text which the descriptions of errors
+// returned by Telegram are matched against.
+var errorDescriptions = map[error]string{
+	ErrBotBlocked:            "bot was blocked by the user",
+	ErrChatNotFound:          "chat not found",
+	ErrMessageToEditNotFound: "message to edit not found",
+	ErrMessageCantBeDeleted:  "message can't be deleted",
+	ErrMessageNotModified:    "message is not modified",
+	ErrFileTooLarge:          "file is too big",
+}
+
+// APIError is returned when Telegram reports that a request was unsuccessful.
+//
+// APIError can be matched against the errors defined in this package using
+// errors.Is, for example:
+//
+//	if errors.Is(err, ted.ErrBotBlocked) {
+//		// stop sending messages to this user
+//	}
+//
+// Unsuccessful requests used to return the Response from Telegram as the
+// error. Code using a type assertion such as err.(ted.Response) must be
+// changed to use errors.As, which can still extract a Response from an
+// APIError.
+type APIError struct {
+	// ErrorCode is the error code returned by Telegram. Its contents are
+	// subject to change in the future.
+	ErrorCode int
+
+	// Description is a human-readable description of the error.
+	Description string
+
+	// RetryAfter is the number of seconds left to wait before the request
+	// can be repeated, in case of exceeding flood control.
+	RetryAfter int
+
+	// MigrateToChatID is the identifier of the supergroup a group has been
+	// migrated to, if the request was made to a migrated group.
+	MigrateToChatID int64
+}
+
+func (e *APIError) Error() string {
+	return e.Description
+}
+
+// Is reports whether e matches target, which should be one of the errors
+// defined in this package.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrTooManyRequests:
+		return e.ErrorCode == 429
+	case ErrChatMigrated:
+		return e.MigrateToChatID != 0
+	}
+	description, ok := errorDescriptions[target]
+	return ok && strings.Contains(e.Description, description)
+}
+
+// As sets target to the Response describing e if target is a *Response, so
+// that code which used to inspect the Response returned as an error can use
+// errors.As.
+func (e *APIError) As(target interface{}) bool {
+	r, ok := target.(*Response)
+	if !ok {
+		return false
+	}
+	*r = Response{
+		ErrorCode:   e.ErrorCode,
+		Description: e.Description,
+	}
+	if e.RetryAfter != 0 || e.MigrateToChatID != 0 {
+		r.Parameters = &ResponseParameters{
+			RetryAfter:      e.RetryAfter,
+			MigrateToChatID: e.MigrateToChatID,
+		}
+	}
+	return true
+}
+
+// apiError returns the APIError described by an unsuccessful response.
+func (r Response) apiError() *APIError {
+	err := &APIError{
+		ErrorCode:   r.ErrorCode,
+		Description: r.Description,
+	}
+	if r.Parameters != nil {
+		err.RetryAfter = r.Parameters.RetryAfter
+		err.MigrateToChatID = r.Parameters.MigrateToChatID
+	}
+	return err
+}
+
+// Unwrap returns the APIError described by r, so that a Response used as an
+// error can be inspected using errors.Is and errors.As.
+func (r Response) Unwrap() error {
+	return r.apiError()
+}
+
+// IsMessageNotModified reports whether err is ErrMessageNotModified.
+func IsMessageNotModified(err error) bool {
+	return errors.Is(err, ErrMessageNotModified)
+}
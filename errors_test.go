@@ -0,0 +1,99 @@
+package ted
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIError_Is(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    *APIError
+		target error
+	}{
+		{
+			name:   "bot blocked",
+			err:    &APIError{ErrorCode: 403, Description: "Forbidden: bot was blocked by the user"},
+			target: ErrBotBlocked,
+		},
+		{
+			name:   "chat not found",
+			err:    &APIError{ErrorCode: 400, Description: "Bad Request: chat not found"},
+			target: ErrChatNotFound,
+		},
+		{
+			name:   "message to edit not found",
+			err:    &APIError{ErrorCode: 400, Description: "Bad Request: message to edit not found"},
+			target: ErrMessageToEditNotFound,
+		},
+		{
+			name:   "message can't be deleted",
+			err:    &APIError{ErrorCode: 400, Description: "Bad Request: message can't be deleted"},
+			target: ErrMessageCantBeDeleted,
+		},
+		{
+			name:   "too many requests",
+			err:    &APIError{ErrorCode: 429, Description: "Too Many Requests: retry after 5", RetryAfter: 5},
+			target: ErrTooManyRequests,
+		},
+		{
+			name:   "chat migrated",
+			err:    &APIError{ErrorCode: 400, Description: "Bad Request: group chat was upgraded to a supergroup chat", MigrateToChatID: -1001234},
+			target: ErrChatMigrated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(t, errors.Is(tt.err, tt.target))
+			assert.False(t, errors.Is(tt.err, ErrMessageNotModified))
+		})
+	}
+}
+
+func TestBot_doReq_APIError(t *testing.T) {
+	client := &httpClient{
+		results: []result{
+			{
+				res: &http.Response{Body: ioutil.NopCloser(strings.NewReader(`{"ok":false,"error_code":400,"description":"Bad Request: group chat was upgraded to a supergroup chat","parameters":{"migrate_to_chat_id":-1001234}}`))},
+			},
+		},
+	}
+	bot := Bot{HTTPClient: client}
+	_, err := bot.Do(SendMessageRequest{ChatID: -1234, Text: "Hello"})
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, 400, apiErr.ErrorCode)
+	assert.Equal(t, int64(-1001234), apiErr.MigrateToChatID)
+	assert.True(t, errors.Is(err, ErrChatMigrated))
+}
+
+func TestAPIError_As(t *testing.T) {
+	var err error = &APIError{
+		ErrorCode:       400,
+		Description:     "Bad Request: group chat was upgraded to a supergroup chat",
+		MigrateToChatID: -1001234,
+	}
+	var res Response
+	assert.True(t, errors.As(err, &res))
+	assert.Equal(t, Response{
+		ErrorCode:   400,
+		Description: "Bad Request: group chat was upgraded to a supergroup chat",
+		Parameters:  &ResponseParameters{MigrateToChatID: -1001234},
+	}, res)
+}
+
+func TestResponse_Unwrap(t *testing.T) {
+	var err error = Response{
+		ErrorCode:   400,
+		Description: "Bad Request: chat not found",
+	}
+	assert.True(t, errors.Is(err, ErrChatNotFound))
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, 400, apiErr.ErrorCode)
+}
@@ -0,0 +1,62 @@
+package ted
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// MaxDownloadSize is the size in bytes of the largest file which can be
+// downloaded from the Bot API.
+const MaxDownloadSize = 20 << 20
+
+// ErrFileTooLarge is returned by DownloadFile when a file is larger than
+// MaxDownloadSize.
+var ErrFileTooLarge = errors.New("ted: file is too big, bots can download files of up to 20 MB in size")
+
+// DownloadFile gets the file identified by fileID and returns its contents.
+// It is the caller's responsibility to close the returned io.ReadCloser.
+//
+// Files are downloaded from the bot's Endpoint. When using a local Bot API
+// server, files larger than MaxDownloadSize can be downloaded, and files
+// with an absolute path are opened from the local file system.
+func (b Bot) DownloadFile(ctx context.Context, fileID string) (io.ReadCloser, error) {
+	res, err := b.DoContext(ctx, GetFileRequest{FileID: fileID})
+	if err != nil {
+		if errors.Is(err, ErrFileTooLarge) {
+			return nil, ErrFileTooLarge
+		}
+		return nil, err
+	}
+	var file File
+	err = json.Unmarshal(res.Result, &file)
+	if err != nil {
+		return nil, err
+	}
+	if b.Endpoint.Local {
+		if filepath.IsAbs(file.FilePath) {
+			return os.Open(file.FilePath)
+		}
+	} else if file.FileSize > MaxDownloadSize {
+		return nil, ErrFileTooLarge
+	}
+	u := b.Endpoint.fileURL(b.Token, file.FilePath)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	r, err := b.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if r.StatusCode != http.StatusOK {
+		r.Body.Close()
+		return nil, fmt.Errorf("ted: error downloading file: %s", r.Status)
+	}
+	return r.Body, nil
+}
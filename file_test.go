@@ -0,0 +1,76 @@
+package ted
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBot_DownloadFile(t *testing.T) {
+	t.Run("downloads file", func(t *testing.T) {
+		client := &httpClient{
+			results: []result{
+				okResponse(`{"file_id":"abc","file_unique_id":"def","file_size":8,"file_path":"photos/file_0.jpg"}`),
+				{
+					res: &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader("contents"))},
+				},
+			},
+		}
+		bot := Bot{Token: "TOKEN", HTTPClient: client, Endpoint: Endpoint{URL: "http://localhost:8081/"}}
+		file, err := bot.DownloadFile(context.Background(), "abc")
+		assert.NoError(t, err)
+		defer file.Close()
+		contents, err := ioutil.ReadAll(file)
+		assert.NoError(t, err)
+		assert.Equal(t, "contents", string(contents))
+		assert.Equal(t, "http://localhost:8081/file/botTOKEN/photos/file_0.jpg", client.requests[1].URL.String())
+	})
+	t.Run("returns error for files which are too large", func(t *testing.T) {
+		client := &httpClient{
+			results: []result{
+				okResponse(`{"file_id":"abc","file_unique_id":"def","file_size":20971521}`),
+			},
+		}
+		bot := Bot{HTTPClient: client}
+		_, err := bot.DownloadFile(context.Background(), "abc")
+		assert.Equal(t, ErrFileTooLarge, err)
+	})
+	t.Run("returns error when Telegram refuses large files", func(t *testing.T) {
+		client := &httpClient{
+			results: []result{
+				{
+					res: &http.Response{Body: ioutil.NopCloser(strings.NewReader(`{"ok":false,"error_code":400,"description":"Bad Request: file is too big"}`))},
+				},
+			},
+		}
+		bot := Bot{HTTPClient: client}
+		_, err := bot.DownloadFile(context.Background(), "abc")
+		assert.Equal(t, ErrFileTooLarge, err)
+	})
+	t.Run("opens local files when using a local server", func(t *testing.T) {
+		f, err := ioutil.TempFile("", "ted")
+		assert.NoError(t, err)
+		defer os.Remove(f.Name())
+		_, err = f.WriteString("contents")
+		assert.NoError(t, err)
+		assert.NoError(t, f.Close())
+		client := &httpClient{
+			results: []result{
+				okResponse(`{"file_id":"abc","file_unique_id":"def","file_size":8,"file_path":` + strconv.Quote(f.Name()) + `}`),
+			},
+		}
+		bot := Bot{HTTPClient: client, Endpoint: Endpoint{URL: "http://localhost:8081", Local: true}}
+		file, err := bot.DownloadFile(context.Background(), "abc")
+		assert.NoError(t, err)
+		defer file.Close()
+		contents, err := ioutil.ReadAll(file)
+		assert.NoError(t, err)
+		assert.Equal(t, "contents", string(contents))
+	})
+}
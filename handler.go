@@ -0,0 +1,19 @@
+package ted
+
+import (
+	"context"
+)
+
+// An UpdateHandler responds to an update received from Telegram.
+type UpdateHandler interface {
+	HandleUpdate(ctx context.Context, update Update)
+}
+
+// The UpdateHandlerFunc type is an adapter to allow the use of ordinary
+// functions as update handlers.
+type UpdateHandlerFunc func(ctx context.Context, update Update)
+
+// HandleUpdate calls f(ctx, update).
+func (f UpdateHandlerFunc) HandleUpdate(ctx context.Context, update Update) {
+	f(ctx, update)
+}
@@ -0,0 +1,1115 @@
+package ted
+
+import (
+	"encoding/json"
+)
+
+// InlineQueryResultPhoto represents a link to a photo. By default, this photo
+// will be sent by the user with optional caption. Alternatively, you can use
+// InputMessageContent to send a message with the specified content instead of
+// the photo.
+type InlineQueryResultPhoto struct {
+	// Unique identifier for this result, 1-64 bytes
+	ID string
+
+	// A valid URL of the photo. Photo must be in JPEG format. Photo size must
+	// not exceed 5MB
+	PhotoURL string
+
+	// URL of the thumbnail for the photo
+	ThumbURL string
+
+	// Optional. Width of the photo
+	PhotoWidth int
+
+	// Optional. Height of the photo
+	PhotoHeight int
+
+	// Optional. Title for the result
+	Title string
+
+	// Optional. Short description of the result
+	Description string
+
+	// Optional. Caption of the photo to be sent, 0-1024 characters after
+	// entities parsing
+	Caption string
+
+	// Optional. Mode for parsing entities in the photo caption.
+	ParseMode string
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup
+
+	// Optional. Content of the message to be sent instead of the photo
+	InputMessageContent InputMessageContent
+}
+
+func (i InlineQueryResultPhoto) inlineQueryResult() {}
+
+func (i InlineQueryResultPhoto) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type                string                `json:"type"`
+		ID                  string                `json:"id"`
+		PhotoURL            string                `json:"photo_url"`
+		ThumbURL            string                `json:"thumb_url"`
+		PhotoWidth          int                   `json:"photo_width,omitempty"`
+		PhotoHeight         int                   `json:"photo_height,omitempty"`
+		Title               string                `json:"title,omitempty"`
+		Description         string                `json:"description,omitempty"`
+		Caption             string                `json:"caption,omitempty"`
+		ParseMode           string                `json:"parse_mode,omitempty"`
+		ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+		InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+	}{
+		Type:                "photo",
+		ID:                  i.ID,
+		PhotoURL:            i.PhotoURL,
+		ThumbURL:            i.ThumbURL,
+		PhotoWidth:          i.PhotoWidth,
+		PhotoHeight:         i.PhotoHeight,
+		Title:               i.Title,
+		Description:         i.Description,
+		Caption:             i.Caption,
+		ParseMode:           i.ParseMode,
+		ReplyMarkup:         i.ReplyMarkup,
+		InputMessageContent: i.InputMessageContent,
+	})
+}
+
+// InlineQueryResultGif represents a link to an animated GIF file. By default,
+// this animated GIF file will be sent by the user with optional caption.
+// Alternatively, you can use InputMessageContent to send a message with the
+// specified content instead of the animation.
+type InlineQueryResultGif struct {
+	// Unique identifier for this result, 1-64 bytes
+	ID string
+
+	// A valid URL for the GIF file. File size must not exceed 1MB
+	GifURL string
+
+	// Optional. Width of the GIF
+	GifWidth int
+
+	// Optional. Height of the GIF
+	GifHeight int
+
+	// Optional. Duration of the GIF in seconds
+	GifDuration int
+
+	// URL of the static (JPEG or GIF) or animated (MPEG4) thumbnail for the
+	// result
+	ThumbURL string
+
+	// Optional. MIME type of the thumbnail, must be one of “image/jpeg”,
+	// “image/gif”, or “video/mp4”. Defaults to “image/jpeg”
+	ThumbMIMEType string
+
+	// Optional. Title for the result
+	Title string
+
+	// Optional. Caption of the GIF file to be sent, 0-1024 characters after
+	// entities parsing
+	Caption string
+
+	// Optional. Mode for parsing entities in the GIF file caption.
+	ParseMode string
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup
+
+	// Optional. Content of the message to be sent instead of the GIF animation
+	InputMessageContent InputMessageContent
+}
+
+func (i InlineQueryResultGif) inlineQueryResult() {}
+
+func (i InlineQueryResultGif) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type                string                `json:"type"`
+		ID                  string                `json:"id"`
+		GifURL              string                `json:"gif_url"`
+		GifWidth            int                   `json:"gif_width,omitempty"`
+		GifHeight           int                   `json:"gif_height,omitempty"`
+		GifDuration         int                   `json:"gif_duration,omitempty"`
+		ThumbURL            string                `json:"thumb_url"`
+		ThumbMIMEType       string                `json:"thumb_mime_type,omitempty"`
+		Title               string                `json:"title,omitempty"`
+		Caption             string                `json:"caption,omitempty"`
+		ParseMode           string                `json:"parse_mode,omitempty"`
+		ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+		InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+	}{
+		Type:                "gif",
+		ID:                  i.ID,
+		GifURL:              i.GifURL,
+		GifWidth:            i.GifWidth,
+		GifHeight:           i.GifHeight,
+		GifDuration:         i.GifDuration,
+		ThumbURL:            i.ThumbURL,
+		ThumbMIMEType:       i.ThumbMIMEType,
+		Title:               i.Title,
+		Caption:             i.Caption,
+		ParseMode:           i.ParseMode,
+		ReplyMarkup:         i.ReplyMarkup,
+		InputMessageContent: i.InputMessageContent,
+	})
+}
+
+// InlineQueryResultMpeg4Gif represents a link to a video animation
+// (H.264/MPEG-4 AVC video without sound). By default, this animated MPEG-4
+// file will be sent by the user with optional caption. Alternatively, you can
+// use InputMessageContent to send a message with the specified content
+// instead of the animation.
+type InlineQueryResultMpeg4Gif struct {
+	// Unique identifier for this result, 1-64 bytes
+	ID string
+
+	// A valid URL for the MP4 file. File size must not exceed 1MB
+	Mpeg4URL string
+
+	// Optional. Video width
+	Mpeg4Width int
+
+	// Optional. Video height
+	Mpeg4Height int
+
+	// Optional. Video duration in seconds
+	Mpeg4Duration int
+
+	// URL of the static (JPEG or GIF) or animated (MPEG4) thumbnail for the
+	// result
+	ThumbURL string
+
+	// Optional. MIME type of the thumbnail, must be one of “image/jpeg”,
+	// “image/gif”, or “video/mp4”. Defaults to “image/jpeg”
+	ThumbMIMEType string
+
+	// Optional. Title for the result
+	Title string
+
+	// Optional. Caption of the MPEG-4 file to be sent, 0-1024 characters after
+	// entities parsing
+	Caption string
+
+	// Optional. Mode for parsing entities in the MPEG-4 file caption.
+	ParseMode string
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup
+
+	// Optional. Content of the message to be sent instead of the video animation
+	InputMessageContent InputMessageContent
+}
+
+func (i InlineQueryResultMpeg4Gif) inlineQueryResult() {}
+
+func (i InlineQueryResultMpeg4Gif) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type                string                `json:"type"`
+		ID                  string                `json:"id"`
+		Mpeg4URL            string                `json:"mpeg4_url"`
+		Mpeg4Width          int                   `json:"mpeg4_width,omitempty"`
+		Mpeg4Height         int                   `json:"mpeg4_height,omitempty"`
+		Mpeg4Duration       int                   `json:"mpeg4_duration,omitempty"`
+		ThumbURL            string                `json:"thumb_url"`
+		ThumbMIMEType       string                `json:"thumb_mime_type,omitempty"`
+		Title               string                `json:"title,omitempty"`
+		Caption             string                `json:"caption,omitempty"`
+		ParseMode           string                `json:"parse_mode,omitempty"`
+		ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+		InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+	}{
+		Type:                "mpeg4_gif",
+		ID:                  i.ID,
+		Mpeg4URL:            i.Mpeg4URL,
+		Mpeg4Width:          i.Mpeg4Width,
+		Mpeg4Height:         i.Mpeg4Height,
+		Mpeg4Duration:       i.Mpeg4Duration,
+		ThumbURL:            i.ThumbURL,
+		ThumbMIMEType:       i.ThumbMIMEType,
+		Title:               i.Title,
+		Caption:             i.Caption,
+		ParseMode:           i.ParseMode,
+		ReplyMarkup:         i.ReplyMarkup,
+		InputMessageContent: i.InputMessageContent,
+	})
+}
+
+// InlineQueryResultVideo represents a link to a page containing an embedded
+// video player or a video file. By default, this video file will be sent by
+// the user with an optional caption. Alternatively, you can use
+// InputMessageContent to send a message with the specified content instead of
+// the video.
+//
+// If an InlineQueryResultVideo message contains an embedded video (e.g.,
+// YouTube), you must replace its content using InputMessageContent.
+type InlineQueryResultVideo struct {
+	// Unique identifier for this result, 1-64 bytes
+	ID string
+
+	// A valid URL for the embedded video player or video file
+	VideoURL string
+
+	// Mime type of the content of video url, “text/html” or “video/mp4”
+	MIMEType string
+
+	// URL of the thumbnail (JPEG only) for the video
+	ThumbURL string
+
+	// Title for the result
+	Title string
+
+	// Optional. Caption of the video to be sent, 0-1024 characters after
+	// entities parsing
+	Caption string
+
+	// Optional. Mode for parsing entities in the video caption.
+	ParseMode string
+
+	// Optional. Video width
+	VideoWidth int
+
+	// Optional. Video height
+	VideoHeight int
+
+	// Optional. Video duration in seconds
+	VideoDuration int
+
+	// Optional. Short description of the result
+	Description string
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup
+
+	// Optional. Content of the message to be sent instead of the video. This
+	// field is required if InlineQueryResultVideo is used to send an HTML-page
+	// as a result (e.g., a YouTube video).
+	InputMessageContent InputMessageContent
+}
+
+func (i InlineQueryResultVideo) inlineQueryResult() {}
+
+func (i InlineQueryResultVideo) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type                string                `json:"type"`
+		ID                  string                `json:"id"`
+		VideoURL            string                `json:"video_url"`
+		MIMEType            string                `json:"mime_type"`
+		ThumbURL            string                `json:"thumb_url"`
+		Title               string                `json:"title"`
+		Caption             string                `json:"caption,omitempty"`
+		ParseMode           string                `json:"parse_mode,omitempty"`
+		VideoWidth          int                   `json:"video_width,omitempty"`
+		VideoHeight         int                   `json:"video_height,omitempty"`
+		VideoDuration       int                   `json:"video_duration,omitempty"`
+		Description         string                `json:"description,omitempty"`
+		ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+		InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+	}{
+		Type:                "video",
+		ID:                  i.ID,
+		VideoURL:            i.VideoURL,
+		MIMEType:            i.MIMEType,
+		ThumbURL:            i.ThumbURL,
+		Title:               i.Title,
+		Caption:             i.Caption,
+		ParseMode:           i.ParseMode,
+		VideoWidth:          i.VideoWidth,
+		VideoHeight:         i.VideoHeight,
+		VideoDuration:       i.VideoDuration,
+		Description:         i.Description,
+		ReplyMarkup:         i.ReplyMarkup,
+		InputMessageContent: i.InputMessageContent,
+	})
+}
+
+// InlineQueryResultAudio represents a link to an MP3 audio file. By default,
+// this audio file will be sent by the user. Alternatively, you can use
+// InputMessageContent to send a message with the specified content instead of
+// the audio.
+type InlineQueryResultAudio struct {
+	// Unique identifier for this result, 1-64 bytes
+	ID string
+
+	// A valid URL for the audio file
+	AudioURL string
+
+	// Title
+	Title string
+
+	// Optional. Caption of the audio to be sent, 0-1024 characters after
+	// entities parsing
+	Caption string
+
+	// Optional. Mode for parsing entities in the audio caption.
+	ParseMode string
+
+	// Optional. Performer
+	Performer string
+
+	// Optional. Audio duration in seconds
+	AudioDuration int
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup
+
+	// Optional. Content of the message to be sent instead of the audio
+	InputMessageContent InputMessageContent
+}
+
+func (i InlineQueryResultAudio) inlineQueryResult() {}
+
+func (i InlineQueryResultAudio) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type                string                `json:"type"`
+		ID                  string                `json:"id"`
+		AudioURL            string                `json:"audio_url"`
+		Title               string                `json:"title"`
+		Caption             string                `json:"caption,omitempty"`
+		ParseMode           string                `json:"parse_mode,omitempty"`
+		Performer           string                `json:"performer,omitempty"`
+		AudioDuration       int                   `json:"audio_duration,omitempty"`
+		ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+		InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+	}{
+		Type:                "audio",
+		ID:                  i.ID,
+		AudioURL:            i.AudioURL,
+		Title:               i.Title,
+		Caption:             i.Caption,
+		ParseMode:           i.ParseMode,
+		Performer:           i.Performer,
+		AudioDuration:       i.AudioDuration,
+		ReplyMarkup:         i.ReplyMarkup,
+		InputMessageContent: i.InputMessageContent,
+	})
+}
+
+// InlineQueryResultVoice represents a link to a voice recording in an .OGG
+// container encoded with OPUS. By default, this voice recording will be sent
+// by the user. Alternatively, you can use InputMessageContent to send a
+// message with the specified content instead of the voice message.
+type InlineQueryResultVoice struct {
+	// Unique identifier for this result, 1-64 bytes
+	ID string
+
+	// A valid URL for the voice recording
+	VoiceURL string
+
+	// Recording title
+	Title string
+
+	// Optional. Caption of the voice message to be sent, 0-1024 characters after
+	// entities parsing
+	Caption string
+
+	// Optional. Mode for parsing entities in the voice message caption.
+	ParseMode string
+
+	// Optional. Recording duration in seconds
+	VoiceDuration int
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup
+
+	// Optional. Content of the message to be sent instead of the voice recording
+	InputMessageContent InputMessageContent
+}
+
+func (i InlineQueryResultVoice) inlineQueryResult() {}
+
+func (i InlineQueryResultVoice) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type                string                `json:"type"`
+		ID                  string                `json:"id"`
+		VoiceURL            string                `json:"voice_url"`
+		Title               string                `json:"title"`
+		Caption             string                `json:"caption,omitempty"`
+		ParseMode           string                `json:"parse_mode,omitempty"`
+		VoiceDuration       int                   `json:"voice_duration,omitempty"`
+		ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+		InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+	}{
+		Type:                "voice",
+		ID:                  i.ID,
+		VoiceURL:            i.VoiceURL,
+		Title:               i.Title,
+		Caption:             i.Caption,
+		ParseMode:           i.ParseMode,
+		VoiceDuration:       i.VoiceDuration,
+		ReplyMarkup:         i.ReplyMarkup,
+		InputMessageContent: i.InputMessageContent,
+	})
+}
+
+// InlineQueryResultDocument represents a link to a file. By default, this
+// file will be sent by the user with an optional caption. Alternatively, you
+// can use InputMessageContent to send a message with the specified content
+// instead of the file. Currently, only .PDF and .ZIP files can be sent using
+// this method.
+type InlineQueryResultDocument struct {
+	// Unique identifier for this result, 1-64 bytes
+	ID string
+
+	// Title for the result
+	Title string
+
+	// Optional. Caption of the document to be sent, 0-1024 characters after
+	// entities parsing
+	Caption string
+
+	// Optional. Mode for parsing entities in the document caption.
+	ParseMode string
+
+	// A valid URL for the file
+	DocumentURL string
+
+	// Mime type of the content of the file, either “application/pdf” or
+	// “application/zip”
+	MIMEType string
+
+	// Optional. Short description of the result
+	Description string
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup
+
+	// Optional. Content of the message to be sent instead of the file
+	InputMessageContent InputMessageContent
+
+	// Optional. Url of the thumbnail for the result
+	ThumbURL string
+
+	// Optional. Thumbnail width
+	ThumbWidth int
+
+	// Optional. Thumbnail height
+	ThumbHeight int
+}
+
+func (i InlineQueryResultDocument) inlineQueryResult() {}
+
+func (i InlineQueryResultDocument) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type                string                `json:"type"`
+		ID                  string                `json:"id"`
+		Title               string                `json:"title"`
+		Caption             string                `json:"caption,omitempty"`
+		ParseMode           string                `json:"parse_mode,omitempty"`
+		DocumentURL         string                `json:"document_url"`
+		MIMEType            string                `json:"mime_type"`
+		Description         string                `json:"description,omitempty"`
+		ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+		InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+		ThumbURL            string                `json:"thumb_url,omitempty"`
+		ThumbWidth          int                   `json:"thumb_width,omitempty"`
+		ThumbHeight         int                   `json:"thumb_height,omitempty"`
+	}{
+		Type:                "document",
+		ID:                  i.ID,
+		Title:               i.Title,
+		Caption:             i.Caption,
+		ParseMode:           i.ParseMode,
+		DocumentURL:         i.DocumentURL,
+		MIMEType:            i.MIMEType,
+		Description:         i.Description,
+		ReplyMarkup:         i.ReplyMarkup,
+		InputMessageContent: i.InputMessageContent,
+		ThumbURL:            i.ThumbURL,
+		ThumbWidth:          i.ThumbWidth,
+		ThumbHeight:         i.ThumbHeight,
+	})
+}
+
+// InlineQueryResultVenue represents a venue. By default, the venue will be
+// sent by the user. Alternatively, you can use InputMessageContent to send a
+// message with the specified content instead of the venue.
+type InlineQueryResultVenue struct {
+	// Unique identifier for this result, 1-64 bytes
+	ID string
+
+	// Latitude of the venue location in degrees
+	Latitude float32
+
+	// Longitude of the venue location in degrees
+	Longitude float32
+
+	// Title of the venue
+	Title string
+
+	// Address of the venue
+	Address string
+
+	// Optional. Foursquare identifier of the venue if known
+	FoursquareID string
+
+	// Optional. Foursquare type of the venue, if known.
+	FoursquareType string
+
+	// Optional. Google Places identifier of the venue
+	GooglePlaceID string
+
+	// Optional. Google Places type of the venue.
+	GooglePlaceType string
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup
+
+	// Optional. Content of the message to be sent instead of the venue
+	InputMessageContent InputMessageContent
+
+	// Optional. Url of the thumbnail for the result
+	ThumbURL string
+
+	// Optional. Thumbnail width
+	ThumbWidth int
+
+	// Optional. Thumbnail height
+	ThumbHeight int
+}
+
+func (i InlineQueryResultVenue) inlineQueryResult() {}
+
+func (i InlineQueryResultVenue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type                string                `json:"type"`
+		ID                  string                `json:"id"`
+		Latitude            float32               `json:"latitude"`
+		Longitude           float32               `json:"longitude"`
+		Title               string                `json:"title"`
+		Address             string                `json:"address"`
+		FoursquareID        string                `json:"foursquare_id,omitempty"`
+		FoursquareType      string                `json:"foursquare_type,omitempty"`
+		GooglePlaceID       string                `json:"google_place_id,omitempty"`
+		GooglePlaceType     string                `json:"google_place_type,omitempty"`
+		ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+		InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+		ThumbURL            string                `json:"thumb_url,omitempty"`
+		ThumbWidth          int                   `json:"thumb_width,omitempty"`
+		ThumbHeight         int                   `json:"thumb_height,omitempty"`
+	}{
+		Type:                "venue",
+		ID:                  i.ID,
+		Latitude:            i.Latitude,
+		Longitude:           i.Longitude,
+		Title:               i.Title,
+		Address:             i.Address,
+		FoursquareID:        i.FoursquareID,
+		FoursquareType:      i.FoursquareType,
+		GooglePlaceID:       i.GooglePlaceID,
+		GooglePlaceType:     i.GooglePlaceType,
+		ReplyMarkup:         i.ReplyMarkup,
+		InputMessageContent: i.InputMessageContent,
+		ThumbURL:            i.ThumbURL,
+		ThumbWidth:          i.ThumbWidth,
+		ThumbHeight:         i.ThumbHeight,
+	})
+}
+
+// InlineQueryResultContact represents a contact with a phone number. By
+// default, this contact will be sent by the user. Alternatively, you can use
+// InputMessageContent to send a message with the specified content instead of
+// the contact.
+type InlineQueryResultContact struct {
+	// Unique identifier for this result, 1-64 bytes
+	ID string
+
+	// Contact's phone number
+	PhoneNumber string
+
+	// Contact's first name
+	FirstName string
+
+	// Optional. Contact's last name
+	LastName string
+
+	// Optional. Additional data about the contact in the form of a vCard, 0-2048
+	// bytes
+	VCard string
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup
+
+	// Optional. Content of the message to be sent instead of the contact
+	InputMessageContent InputMessageContent
+
+	// Optional. Url of the thumbnail for the result
+	ThumbURL string
+
+	// Optional. Thumbnail width
+	ThumbWidth int
+
+	// Optional. Thumbnail height
+	ThumbHeight int
+}
+
+func (i InlineQueryResultContact) inlineQueryResult() {}
+
+func (i InlineQueryResultContact) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type                string                `json:"type"`
+		ID                  string                `json:"id"`
+		PhoneNumber         string                `json:"phone_number"`
+		FirstName           string                `json:"first_name"`
+		LastName            string                `json:"last_name,omitempty"`
+		VCard               string                `json:"vcard,omitempty"`
+		ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+		InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+		ThumbURL            string                `json:"thumb_url,omitempty"`
+		ThumbWidth          int                   `json:"thumb_width,omitempty"`
+		ThumbHeight         int                   `json:"thumb_height,omitempty"`
+	}{
+		Type:                "contact",
+		ID:                  i.ID,
+		PhoneNumber:         i.PhoneNumber,
+		FirstName:           i.FirstName,
+		LastName:            i.LastName,
+		VCard:               i.VCard,
+		ReplyMarkup:         i.ReplyMarkup,
+		InputMessageContent: i.InputMessageContent,
+		ThumbURL:            i.ThumbURL,
+		ThumbWidth:          i.ThumbWidth,
+		ThumbHeight:         i.ThumbHeight,
+	})
+}
+
+// InlineQueryResultGame represents a Game.
+type InlineQueryResultGame struct {
+	// Unique identifier for this result, 1-64 bytes
+	ID string
+
+	// Short name of the game
+	GameShortName string
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup
+}
+
+func (i InlineQueryResultGame) inlineQueryResult() {}
+
+func (i InlineQueryResultGame) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type          string                `json:"type"`
+		ID            string                `json:"id"`
+		GameShortName string                `json:"game_short_name"`
+		ReplyMarkup   *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	}{
+		Type:          "game",
+		ID:            i.ID,
+		GameShortName: i.GameShortName,
+		ReplyMarkup:   i.ReplyMarkup,
+	})
+}
+
+// InlineQueryResultCachedPhoto represents a link to a photo stored on the
+// Telegram servers. By default, this photo will be sent by the user with an
+// optional caption. Alternatively, you can use InputMessageContent to send a
+// message with the specified content instead of the photo.
+type InlineQueryResultCachedPhoto struct {
+	// Unique identifier for this result, 1-64 bytes
+	ID string
+
+	// A valid file identifier of the photo
+	PhotoFileID string
+
+	// Optional. Title for the result
+	Title string
+
+	// Optional. Short description of the result
+	Description string
+
+	// Optional. Caption of the photo to be sent, 0-1024 characters after
+	// entities parsing
+	Caption string
+
+	// Optional. Mode for parsing entities in the photo caption.
+	ParseMode string
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup
+
+	// Optional. Content of the message to be sent instead of the photo
+	InputMessageContent InputMessageContent
+}
+
+func (i InlineQueryResultCachedPhoto) inlineQueryResult() {}
+
+func (i InlineQueryResultCachedPhoto) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type                string                `json:"type"`
+		ID                  string                `json:"id"`
+		PhotoFileID         string                `json:"photo_file_id"`
+		Title               string                `json:"title,omitempty"`
+		Description         string                `json:"description,omitempty"`
+		Caption             string                `json:"caption,omitempty"`
+		ParseMode           string                `json:"parse_mode,omitempty"`
+		ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+		InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+	}{
+		Type:                "photo",
+		ID:                  i.ID,
+		PhotoFileID:         i.PhotoFileID,
+		Title:               i.Title,
+		Description:         i.Description,
+		Caption:             i.Caption,
+		ParseMode:           i.ParseMode,
+		ReplyMarkup:         i.ReplyMarkup,
+		InputMessageContent: i.InputMessageContent,
+	})
+}
+
+// InlineQueryResultCachedGif represents a link to an animated GIF file stored
+// on the Telegram servers. By default, this animated GIF file will be sent by
+// the user with an optional caption. Alternatively, you can use
+// InputMessageContent to send a message with specified content instead of the
+// animation.
+type InlineQueryResultCachedGif struct {
+	// Unique identifier for this result, 1-64 bytes
+	ID string
+
+	// A valid file identifier for the GIF file
+	GifFileID string
+
+	// Optional. Title for the result
+	Title string
+
+	// Optional. Caption of the GIF file to be sent, 0-1024 characters after
+	// entities parsing
+	Caption string
+
+	// Optional. Mode for parsing entities in the GIF file caption.
+	ParseMode string
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup
+
+	// Optional. Content of the message to be sent instead of the GIF animation
+	InputMessageContent InputMessageContent
+}
+
+func (i InlineQueryResultCachedGif) inlineQueryResult() {}
+
+func (i InlineQueryResultCachedGif) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type                string                `json:"type"`
+		ID                  string                `json:"id"`
+		GifFileID           string                `json:"gif_file_id"`
+		Title               string                `json:"title,omitempty"`
+		Caption             string                `json:"caption,omitempty"`
+		ParseMode           string                `json:"parse_mode,omitempty"`
+		ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+		InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+	}{
+		Type:                "gif",
+		ID:                  i.ID,
+		GifFileID:           i.GifFileID,
+		Title:               i.Title,
+		Caption:             i.Caption,
+		ParseMode:           i.ParseMode,
+		ReplyMarkup:         i.ReplyMarkup,
+		InputMessageContent: i.InputMessageContent,
+	})
+}
+
+// InlineQueryResultCachedMpeg4Gif represents a link to a video animation
+// (H.264/MPEG-4 AVC video without sound) stored on the Telegram servers. By
+// default, this animated MPEG-4 file will be sent by the user with an
+// optional caption. Alternatively, you can use InputMessageContent to send a
+// message with the specified content instead of the animation.
+type InlineQueryResultCachedMpeg4Gif struct {
+	// Unique identifier for this result, 1-64 bytes
+	ID string
+
+	// A valid file identifier for the MP4 file
+	Mpeg4FileID string
+
+	// Optional. Title for the result
+	Title string
+
+	// Optional. Caption of the MPEG-4 file to be sent, 0-1024 characters after
+	// entities parsing
+	Caption string
+
+	// Optional. Mode for parsing entities in the MPEG-4 file caption.
+	ParseMode string
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup
+
+	// Optional. Content of the message to be sent instead of the video animation
+	InputMessageContent InputMessageContent
+}
+
+func (i InlineQueryResultCachedMpeg4Gif) inlineQueryResult() {}
+
+func (i InlineQueryResultCachedMpeg4Gif) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type                string                `json:"type"`
+		ID                  string                `json:"id"`
+		Mpeg4FileID         string                `json:"mpeg4_file_id"`
+		Title               string                `json:"title,omitempty"`
+		Caption             string                `json:"caption,omitempty"`
+		ParseMode           string                `json:"parse_mode,omitempty"`
+		ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+		InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+	}{
+		Type:                "mpeg4_gif",
+		ID:                  i.ID,
+		Mpeg4FileID:         i.Mpeg4FileID,
+		Title:               i.Title,
+		Caption:             i.Caption,
+		ParseMode:           i.ParseMode,
+		ReplyMarkup:         i.ReplyMarkup,
+		InputMessageContent: i.InputMessageContent,
+	})
+}
+
+// InlineQueryResultCachedSticker represents a link to a sticker stored on the
+// Telegram servers. By default, this sticker will be sent by the user.
+// Alternatively, you can use InputMessageContent to send a message with the
+// specified content instead of the sticker.
+type InlineQueryResultCachedSticker struct {
+	// Unique identifier for this result, 1-64 bytes
+	ID string
+
+	// A valid file identifier of the sticker
+	StickerFileID string
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup
+
+	// Optional. Content of the message to be sent instead of the sticker
+	InputMessageContent InputMessageContent
+}
+
+func (i InlineQueryResultCachedSticker) inlineQueryResult() {}
+
+func (i InlineQueryResultCachedSticker) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type                string                `json:"type"`
+		ID                  string                `json:"id"`
+		StickerFileID       string                `json:"sticker_file_id"`
+		ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+		InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+	}{
+		Type:                "sticker",
+		ID:                  i.ID,
+		StickerFileID:       i.StickerFileID,
+		ReplyMarkup:         i.ReplyMarkup,
+		InputMessageContent: i.InputMessageContent,
+	})
+}
+
+// InlineQueryResultCachedDocument represents a link to a file stored on the
+// Telegram servers. By default, this file will be sent by the user with an
+// optional caption. Alternatively, you can use InputMessageContent to send a
+// message with the specified content instead of the file.
+type InlineQueryResultCachedDocument struct {
+	// Unique identifier for this result, 1-64 bytes
+	ID string
+
+	// Title for the result
+	Title string
+
+	// A valid file identifier for the file
+	DocumentFileID string
+
+	// Optional. Short description of the result
+	Description string
+
+	// Optional. Caption of the document to be sent, 0-1024 characters after
+	// entities parsing
+	Caption string
+
+	// Optional. Mode for parsing entities in the document caption.
+	ParseMode string
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup
+
+	// Optional. Content of the message to be sent instead of the file
+	InputMessageContent InputMessageContent
+}
+
+func (i InlineQueryResultCachedDocument) inlineQueryResult() {}
+
+func (i InlineQueryResultCachedDocument) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type                string                `json:"type"`
+		ID                  string                `json:"id"`
+		Title               string                `json:"title"`
+		DocumentFileID      string                `json:"document_file_id"`
+		Description         string                `json:"description,omitempty"`
+		Caption             string                `json:"caption,omitempty"`
+		ParseMode           string                `json:"parse_mode,omitempty"`
+		ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+		InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+	}{
+		Type:                "document",
+		ID:                  i.ID,
+		Title:               i.Title,
+		DocumentFileID:      i.DocumentFileID,
+		Description:         i.Description,
+		Caption:             i.Caption,
+		ParseMode:           i.ParseMode,
+		ReplyMarkup:         i.ReplyMarkup,
+		InputMessageContent: i.InputMessageContent,
+	})
+}
+
+// InlineQueryResultCachedVideo represents a link to a video file stored on
+// the Telegram servers. By default, this video file will be sent by the user
+// with an optional caption. Alternatively, you can use InputMessageContent to
+// send a message with the specified content instead of the video.
+type InlineQueryResultCachedVideo struct {
+	// Unique identifier for this result, 1-64 bytes
+	ID string
+
+	// A valid file identifier for the video file
+	VideoFileID string
+
+	// Title for the result
+	Title string
+
+	// Optional. Short description of the result
+	Description string
+
+	// Optional. Caption of the video to be sent, 0-1024 characters after
+	// entities parsing
+	Caption string
+
+	// Optional. Mode for parsing entities in the video caption.
+	ParseMode string
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup
+
+	// Optional. Content of the message to be sent instead of the video
+	InputMessageContent InputMessageContent
+}
+
+func (i InlineQueryResultCachedVideo) inlineQueryResult() {}
+
+func (i InlineQueryResultCachedVideo) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type                string                `json:"type"`
+		ID                  string                `json:"id"`
+		VideoFileID         string                `json:"video_file_id"`
+		Title               string                `json:"title"`
+		Description         string                `json:"description,omitempty"`
+		Caption             string                `json:"caption,omitempty"`
+		ParseMode           string                `json:"parse_mode,omitempty"`
+		ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+		InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+	}{
+		Type:                "video",
+		ID:                  i.ID,
+		VideoFileID:         i.VideoFileID,
+		Title:               i.Title,
+		Description:         i.Description,
+		Caption:             i.Caption,
+		ParseMode:           i.ParseMode,
+		ReplyMarkup:         i.ReplyMarkup,
+		InputMessageContent: i.InputMessageContent,
+	})
+}
+
+// InlineQueryResultCachedVoice represents a link to a voice message stored on
+// the Telegram servers. By default, this voice message will be sent by the
+// user. Alternatively, you can use InputMessageContent to send a message with
+// the specified content instead of the voice message.
+type InlineQueryResultCachedVoice struct {
+	// Unique identifier for this result, 1-64 bytes
+	ID string
+
+	// A valid file identifier for the voice message
+	VoiceFileID string
+
+	// Voice message title
+	Title string
+
+	// Optional. Caption of the voice message to be sent, 0-1024 characters after
+	// entities parsing
+	Caption string
+
+	// Optional. Mode for parsing entities in the voice message caption.
+	ParseMode string
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup
+
+	// Optional. Content of the message to be sent instead of the voice message
+	InputMessageContent InputMessageContent
+}
+
+func (i InlineQueryResultCachedVoice) inlineQueryResult() {}
+
+func (i InlineQueryResultCachedVoice) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type                string                `json:"type"`
+		ID                  string                `json:"id"`
+		VoiceFileID         string                `json:"voice_file_id"`
+		Title               string                `json:"title"`
+		Caption             string                `json:"caption,omitempty"`
+		ParseMode           string                `json:"parse_mode,omitempty"`
+		ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+		InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+	}{
+		Type:                "voice",
+		ID:                  i.ID,
+		VoiceFileID:         i.VoiceFileID,
+		Title:               i.Title,
+		Caption:             i.Caption,
+		ParseMode:           i.ParseMode,
+		ReplyMarkup:         i.ReplyMarkup,
+		InputMessageContent: i.InputMessageContent,
+	})
+}
+
+// InlineQueryResultCachedAudio represents a link to an MP3 audio file stored
+// on the Telegram servers. By default, this audio file will be sent by the
+// user. Alternatively, you can use InputMessageContent to send a message with
+// the specified content instead of the audio.
+type InlineQueryResultCachedAudio struct {
+	// Unique identifier for this result, 1-64 bytes
+	ID string
+
+	// A valid file identifier for the audio file
+	AudioFileID string
+
+	// Optional. Caption of the audio to be sent, 0-1024 characters after
+	// entities parsing
+	Caption string
+
+	// Optional. Mode for parsing entities in the audio caption.
+	ParseMode string
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup
+
+	// Optional. Content of the message to be sent instead of the audio
+	InputMessageContent InputMessageContent
+}
+
+func (i InlineQueryResultCachedAudio) inlineQueryResult() {}
+
+func (i InlineQueryResultCachedAudio) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type                string                `json:"type"`
+		ID                  string                `json:"id"`
+		AudioFileID         string                `json:"audio_file_id"`
+		Caption             string                `json:"caption,omitempty"`
+		ParseMode           string                `json:"parse_mode,omitempty"`
+		ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+		InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+	}{
+		Type:                "audio",
+		ID:                  i.ID,
+		AudioFileID:         i.AudioFileID,
+		Caption:             i.Caption,
+		ParseMode:           i.ParseMode,
+		ReplyMarkup:         i.ReplyMarkup,
+		InputMessageContent: i.InputMessageContent,
+	})
+}
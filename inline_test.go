@@ -0,0 +1,140 @@
+package ted
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInlineQueryResult_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		result   InlineQueryResult
+		expected string
+	}{
+		{
+			name: "photo",
+			result: InlineQueryResultPhoto{
+				ID:       "1",
+				PhotoURL: "https://example.com/photo.jpg",
+				ThumbURL: "https://example.com/thumb.jpg",
+				Caption:  "Caption",
+			},
+			expected: `{"type":"photo","id":"1","photo_url":"https://example.com/photo.jpg","thumb_url":"https://example.com/thumb.jpg","caption":"Caption"}`,
+		},
+		{
+			name: "mpeg4 gif",
+			result: InlineQueryResultMpeg4Gif{
+				ID:            "2",
+				Mpeg4URL:      "https://example.com/anim.mp4",
+				ThumbURL:      "https://example.com/anim.mp4",
+				ThumbMIMEType: "video/mp4",
+			},
+			expected: `{"type":"mpeg4_gif","id":"2","mpeg4_url":"https://example.com/anim.mp4","thumb_url":"https://example.com/anim.mp4","thumb_mime_type":"video/mp4"}`,
+		},
+		{
+			name: "video",
+			result: InlineQueryResultVideo{
+				ID:            "7",
+				VideoURL:      "https://example.com/video.mp4",
+				MIMEType:      "video/mp4",
+				ThumbURL:      "https://example.com/thumb.jpg",
+				Title:         "Video",
+				VideoDuration: 30,
+			},
+			expected: `{"type":"video","id":"7","video_url":"https://example.com/video.mp4","mime_type":"video/mp4","thumb_url":"https://example.com/thumb.jpg","title":"Video","video_duration":30}`,
+		},
+		{
+			name: "document",
+			result: InlineQueryResultDocument{
+				ID:          "8",
+				Title:       "Report",
+				DocumentURL: "https://example.com/report.pdf",
+				MIMEType:    "application/pdf",
+				Description: "Annual report",
+			},
+			expected: `{"type":"document","id":"8","title":"Report","document_url":"https://example.com/report.pdf","mime_type":"application/pdf","description":"Annual report"}`,
+		},
+		{
+			name: "venue",
+			result: InlineQueryResultVenue{
+				ID:           "9",
+				Latitude:     1.5,
+				Longitude:    103.5,
+				Title:        "Marina Bay Sands",
+				Address:      "10 Bayfront Ave",
+				FoursquareID: "4b05886bf964a520a0c922e3",
+			},
+			expected: `{"type":"venue","id":"9","latitude":1.5,"longitude":103.5,"title":"Marina Bay Sands","address":"10 Bayfront Ave","foursquare_id":"4b05886bf964a520a0c922e3"}`,
+		},
+		{
+			name: "cached photo",
+			result: InlineQueryResultCachedPhoto{
+				ID:          "3",
+				PhotoFileID: "abc",
+			},
+			expected: `{"type":"photo","id":"3","photo_file_id":"abc"}`,
+		},
+		{
+			name: "cached sticker with content",
+			result: InlineQueryResultCachedSticker{
+				ID:                  "4",
+				StickerFileID:       "def",
+				InputMessageContent: InputTextMessageContent{Text: "Hello"},
+			},
+			expected: `{"type":"sticker","id":"4","sticker_file_id":"def","input_message_content":{"message_text":"Hello"}}`,
+		},
+		{
+			name: "cached audio",
+			result: InlineQueryResultCachedAudio{
+				ID:          "10",
+				AudioFileID: "ghi",
+				Caption:     "*Song*",
+				ParseMode:   "MarkdownV2",
+			},
+			expected: `{"type":"audio","id":"10","audio_file_id":"ghi","caption":"*Song*","parse_mode":"MarkdownV2"}`,
+		},
+		{
+			name: "cached document",
+			result: InlineQueryResultCachedDocument{
+				ID:             "11",
+				Title:          "Report",
+				DocumentFileID: "jkl",
+				ReplyMarkup: &InlineKeyboardMarkup{
+					InlineKeyboard: [][]InlineKeyboardButton{{{Text: "Open", URL: "https://example.com"}}},
+				},
+			},
+			expected: `{"type":"document","id":"11","title":"Report","document_file_id":"jkl","reply_markup":{"inline_keyboard":[[{"text":"Open","url":"https://example.com"}]]}}`,
+		},
+		{
+			name: "contact",
+			result: InlineQueryResultContact{
+				ID:          "5",
+				PhoneNumber: "+6512345678",
+				FirstName:   "Jane",
+				InputMessageContent: InputContactMessageContent{
+					PhoneNumber: "+6512345678",
+					FirstName:   "Jane",
+					LastName:    "Doe",
+				},
+			},
+			expected: `{"type":"contact","id":"5","phone_number":"+6512345678","first_name":"Jane","input_message_content":{"phone_number":"+6512345678","first_name":"Jane","last_name":"Doe"}}`,
+		},
+		{
+			name: "game",
+			result: InlineQueryResultGame{
+				ID:            "6",
+				GameShortName: "tetris",
+			},
+			expected: `{"type":"game","id":"6","game_short_name":"tetris"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := json.Marshal(tt.result)
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(actual))
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package ted
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// A Limiter delays requests to avoid exceeding Telegram's rate limits.
+type Limiter interface {
+	// Wait blocks until a request to the chat identified by chatID may be
+	// made or ctx is done, in which case it returns ctx.Err().
+	Wait(ctx context.Context, chatID interface{}) error
+}
+
+// RateLimiter is a Limiter which spaces out requests to chats to stay within
+// the limits on how quickly bots may send messages.
+//
+// RateLimiter treats chat IDs which are negative or are channel usernames as
+// referring to groups, supergroups or channels, and all other chat IDs as
+// referring to private chats.
+type RateLimiter struct {
+	// Global is the minimum interval between any two requests.
+	Global time.Duration
+
+	// Chat is the minimum interval between two requests to the same private
+	// chat.
+	Chat time.Duration
+
+	// Group is the minimum interval between two requests to the same group,
+	// supergroup or channel.
+	Group time.Duration
+
+	mu     sync.Mutex
+	next   time.Time
+	chats  map[string]time.Time
+	pruned time.Time
+	stats  LimiterStats
+}
+
+// NewRateLimiter returns a RateLimiter which allows up to 30 requests per
+// second overall, 1 request per second to the same private chat and 20
+// requests per minute to the same group.
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		Global: time.Second / 30,
+		Chat:   time.Second,
+		Group:  time.Minute / 20,
+	}
+}
+
+// LimiterStats contains statistics about the requests which have passed
+// through a RateLimiter.
+type LimiterStats struct {
+	// Requests is the number of requests which have waited on the limiter.
+	Requests int64
+
+	// Delayed is the number of requests which were delayed.
+	Delayed int64
+
+	// TotalWait is the total amount of time requests were delayed for.
+	TotalWait time.Duration
+
+	// MaxWait is the longest time any request was delayed for.
+	MaxWait time.Duration
+}
+
+// Wait implements Limiter. Requests are delayed until the interval for their
+// chat has passed since the previous request to the same chat, and then
+// until the global interval has passed since the previous request to any
+// chat.
+//
+// If ctx is done before the request may be made, the slots reserved for it
+// are released unless later requests have already been queued behind them.
+func (l *RateLimiter) Wait(ctx context.Context, chatID interface{}) error {
+	start := time.Now()
+	chat := l.reserveChat(chatID)
+	err := l.wait(ctx, chat.at)
+	if err == nil {
+		global := l.reserveGlobal()
+		err = l.wait(ctx, global.at)
+		if err != nil {
+			l.release(global)
+		}
+	}
+	if err != nil {
+		l.release(chat)
+	}
+	l.record(time.Since(start))
+	return err
+}
+
+// reservation is a slot reserved for a request to a chat, or to any chat if
+// chat is empty.
+type reservation struct {
+	chat string
+	at   time.Time
+
+	// prev and next are the times the following slot began before and after
+	// the reservation was made.
+	prev, next time.Time
+}
+
+// reserveChat reserves the next slot for a request to chatID.
+func (l *RateLimiter) reserveChat(chatID interface{}) reservation {
+	key := fmt.Sprint(chatID)
+	interval := l.Chat
+	if isGroup(chatID) {
+		interval = l.Group
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	if l.chats == nil {
+		l.chats = make(map[string]time.Time)
+	}
+	if now.Sub(l.pruned) > time.Minute {
+		for k, next := range l.chats {
+			if next.Before(now) {
+				delete(l.chats, k)
+			}
+		}
+		l.pruned = now
+	}
+	prev := l.chats[key]
+	at := now
+	if prev.After(at) {
+		at = prev
+	}
+	l.chats[key] = at.Add(interval)
+	return reservation{chat: key, at: at, prev: prev, next: l.chats[key]}
+}
+
+// reserveGlobal reserves the next slot for any request.
+func (l *RateLimiter) reserveGlobal() reservation {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	prev := l.next
+	at := time.Now()
+	if prev.After(at) {
+		at = prev
+	}
+	l.next = at.Add(l.Global)
+	return reservation{at: at, prev: prev, next: l.next}
+}
+
+// release gives up r if no slots have been reserved after it since.
+func (l *RateLimiter) release(r reservation) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if r.chat == "" {
+		if l.next.Equal(r.next) {
+			l.next = r.prev
+		}
+		return
+	}
+	if next, ok := l.chats[r.chat]; ok && next.Equal(r.next) {
+		l.chats[r.chat] = r.prev
+	}
+}
+
+func (l *RateLimiter) wait(ctx context.Context, until time.Time) error {
+	if d := time.Until(until); d > 0 && !sleep(ctx, d) {
+		return ctx.Err()
+	}
+	return nil
+}
+
+func (l *RateLimiter) record(wait time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.stats.Requests++
+	// ignore the time spent acquiring locks
+	if wait < time.Millisecond {
+		return
+	}
+	l.stats.Delayed++
+	l.stats.TotalWait += wait
+	if wait > l.stats.MaxWait {
+		l.stats.MaxWait = wait
+	}
+}
+
+// Stats returns statistics about the requests which have passed through the
+// limiter.
+func (l *RateLimiter) Stats() LimiterStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.stats
+}
+
+// isGroup reports whether chatID refers to a group, supergroup or channel.
+func isGroup(chatID interface{}) bool {
+	if id, ok := chatIDInt64(chatID); ok {
+		return id < 0
+	}
+	_, ok := chatID.(string)
+	return ok
+}
+
+// messageRequest is implemented by requests which send messages to a chat,
+// which are the requests subject to Telegram's rate limits.
+type messageRequest interface {
+	Request
+	messageChatID() interface{}
+}
+
+// requestChatID returns the chat request sends a message to, if request sends
+// messages and its ChatID is set.
+func requestChatID(request Request) (interface{}, bool) {
+	r, ok := request.(messageRequest)
+	if !ok {
+		return nil, false
+	}
+	chatID := r.messageChatID()
+	if chatID == nil || chatID == "" {
+		return nil, false
+	}
+	return chatID, true
+}
@@ -0,0 +1,81 @@
+package ted
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter_Wait(t *testing.T) {
+	t.Run("spaces out requests to the same chat", func(t *testing.T) {
+		limiter := &RateLimiter{
+			Chat:  50 * time.Millisecond,
+			Group: 100 * time.Millisecond,
+		}
+		ctx := context.Background()
+		start := time.Now()
+		assert.NoError(t, limiter.Wait(ctx, 123))
+		assert.NoError(t, limiter.Wait(ctx, 456))
+		assert.NoError(t, limiter.Wait(ctx, 123))
+		assert.True(t, time.Since(start) >= 50*time.Millisecond)
+		assert.NoError(t, limiter.Wait(ctx, int64(-100123)))
+		assert.NoError(t, limiter.Wait(ctx, int64(-100123)))
+		assert.True(t, time.Since(start) >= 100*time.Millisecond)
+		stats := limiter.Stats()
+		assert.Equal(t, int64(5), stats.Requests)
+		// requests which were not delayed may still be counted as delayed
+		// if the test is descheduled while making them
+		assert.True(t, stats.Delayed >= 2)
+		assert.True(t, stats.MaxWait >= 90*time.Millisecond)
+	})
+	t.Run("spaces out requests to any chat", func(t *testing.T) {
+		limiter := &RateLimiter{
+			Global: 20 * time.Millisecond,
+		}
+		ctx := context.Background()
+		start := time.Now()
+		for i := 0; i < 3; i++ {
+			assert.NoError(t, limiter.Wait(ctx, i))
+		}
+		assert.True(t, time.Since(start) >= 40*time.Millisecond)
+	})
+	t.Run("returns when context is done", func(t *testing.T) {
+		limiter := &RateLimiter{
+			Chat: time.Hour,
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.NoError(t, limiter.Wait(ctx, 123))
+		assert.Equal(t, context.DeadlineExceeded, limiter.Wait(ctx, 123))
+	})
+	t.Run("releases slots when context is done", func(t *testing.T) {
+		limiter := &RateLimiter{
+			Chat: 50 * time.Millisecond,
+		}
+		assert.NoError(t, limiter.Wait(context.Background(), 123))
+		next := limiter.chats["123"]
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.Equal(t, context.Canceled, limiter.Wait(ctx, 123))
+		assert.Equal(t, next, limiter.chats["123"])
+	})
+}
+
+func Test_requestChatID(t *testing.T) {
+	chatID, ok := requestChatID(SendMessageRequest{ChatID: "@channel"})
+	assert.True(t, ok)
+	assert.Equal(t, "@channel", chatID)
+	_, ok = requestChatID(SendMessageRequest{})
+	assert.False(t, ok)
+	_, ok = requestChatID(AnswerCallbackQueryRequest{CallbackQueryID: "abc"})
+	assert.False(t, ok)
+	chatID, ok = requestChatID(ForwardMessageRequest{ChatID: 123, FromChatID: 456, MessageID: 1})
+	assert.True(t, ok)
+	assert.Equal(t, 123, chatID)
+	_, ok = requestChatID(GetChatRequest{ChatID: 123})
+	assert.False(t, ok)
+	_, ok = requestChatID(SendChatActionRequest{ChatID: 123, Action: ChatActionTyping})
+	assert.False(t, ok)
+}
@@ -0,0 +1,93 @@
+package ted
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// stopLiveLocationTimeout limits how long Follow waits to stop a live location
+// after its context is done.
+const stopLiveLocationTimeout = 10 * time.Second
+
+// LiveLocation is a live location message sent by the bot which can be
+// updated until its live period expires or it is stopped.
+type LiveLocation struct {
+	// Message is the live location message.
+	Message Message
+
+	bot Bot
+}
+
+// StartLiveLocation sends req, which should have a LivePeriod, and returns a
+// LiveLocation for updating the sent message.
+func (b Bot) StartLiveLocation(ctx context.Context, req SendLocationRequest) (*LiveLocation, error) {
+	message, err := Call[Message](ctx, b, req)
+	if err != nil {
+		return nil, err
+	}
+	return &LiveLocation{Message: message, bot: b}, nil
+}
+
+// Update moves the live location to loc. The latitude, longitude, horizontal
+// accuracy, heading and proximity alert radius of loc are used.
+func (l *LiveLocation) Update(ctx context.Context, loc Location) error {
+	message, err := callEdit(ctx, l.bot, EditMessageLiveLocationRequest{
+		ChatID:               l.Message.Chat.ID,
+		MessageID:            l.Message.ID,
+		Latitude:             loc.Latitude,
+		Longitude:            loc.Longitude,
+		HorizontalAccuracy:   loc.HorizontalAccuracy,
+		Heading:              loc.Heading,
+		ProximityAlertRadius: loc.ProximityAlertRadius,
+	})
+	if err != nil {
+		return err
+	}
+	if message != nil {
+		l.Message = *message
+	}
+	return nil
+}
+
+// Stop stops updating the live location before its live period expires.
+func (l *LiveLocation) Stop(ctx context.Context) error {
+	message, err := callEdit(ctx, l.bot, StopMessageLiveLocationRequest{
+		ChatID:    l.Message.Chat.ID,
+		MessageID: l.Message.ID,
+	})
+	if err != nil {
+		return err
+	}
+	if message != nil {
+		l.Message = *message
+	}
+	return nil
+}
+
+// Follow updates the live location to each location received from feed.
+// When feed is closed, the live location is stopped. Follow returns early
+// with ctx.Err() if ctx is done, after trying to stop the live location, or
+// with the error from an update which failed. Updates which do not change the
+// location are ignored.
+func (l *LiveLocation) Follow(ctx context.Context, feed <-chan Location) error {
+	for {
+		select {
+		case loc, ok := <-feed:
+			if !ok {
+				return l.Stop(ctx)
+			}
+			err := l.Update(ctx, loc)
+			if err != nil && !errors.Is(err, ErrMessageNotModified) {
+				return err
+			}
+		case <-ctx.Done():
+			// ctx can no longer be used to make requests, so stop the live
+			// location with a new context instead.
+			stopCtx, cancel := context.WithTimeout(context.Background(), stopLiveLocationTimeout)
+			defer cancel()
+			_ = l.Stop(stopCtx)
+			return ctx.Err()
+		}
+	}
+}
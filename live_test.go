@@ -0,0 +1,78 @@
+package ted
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLiveLocation_Follow(t *testing.T) {
+	notModified := result{
+		res: &http.Response{Body: ioutil.NopCloser(strings.NewReader(`{"ok":false,"error_code":400,"description":"Bad Request: message is not modified"}`))},
+	}
+	client := &httpClient{
+		results: []result{
+			okResponse(`{"message_id":456,"chat":{"id":123,"type":"private"},"location":{"latitude":1.5,"longitude":103.8,"live_period":900}}`),
+			okResponse(`{"message_id":456,"chat":{"id":123,"type":"private"},"location":{"latitude":1.6,"longitude":103.8,"live_period":900}}`),
+			notModified,
+			okResponse(`{"message_id":456,"chat":{"id":123,"type":"private"},"location":{"latitude":1.6,"longitude":103.8}}`),
+		},
+	}
+	bot := Bot{HTTPClient: client}
+	live, err := bot.StartLiveLocation(context.Background(), SendLocationRequest{
+		ChatID:     123,
+		Latitude:   1.5,
+		Longitude:  103.8,
+		LivePeriod: 900,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 456, live.Message.ID)
+
+	feed := make(chan Location, 2)
+	feed <- Location{Latitude: 1.6, Longitude: 103.8, Heading: 90}
+	feed <- Location{Latitude: 1.6, Longitude: 103.8, Heading: 90}
+	close(feed)
+	err = live.Follow(context.Background(), feed)
+	assert.NoError(t, err)
+
+	var methods []string
+	for _, req := range client.requests {
+		methods = append(methods, path.Base(req.URL.Path))
+	}
+	assert.Equal(t, []string{"sendLocation", "editMessageLiveLocation", "editMessageLiveLocation", "stopMessageLiveLocation"}, methods)
+	var edit map[string]interface{}
+	body, _ := client.requests[1].GetBody()
+	assert.NoError(t, json.NewDecoder(body).Decode(&edit))
+	assert.Equal(t, map[string]interface{}{
+		"chat_id":    float64(123),
+		"message_id": float64(456),
+		"latitude":   1.6,
+		"longitude":  103.8,
+		"heading":    float64(90),
+	}, edit)
+}
+
+func TestLiveLocation_Follow_Cancelled(t *testing.T) {
+	var methods []string
+	live := &LiveLocation{
+		Message: Message{ID: 456, Chat: Chat{ID: 123}},
+		bot: Bot{
+			HTTPClient: clientFunc(func(req *http.Request) (*http.Response, error) {
+				assert.NoError(t, req.Context().Err())
+				methods = append(methods, path.Base(req.URL.Path))
+				return okResponse(`{"message_id":456,"chat":{"id":123,"type":"private"},"location":{"latitude":1.6,"longitude":103.8}}`).res, nil
+			}),
+		},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := live.Follow(ctx, make(chan Location))
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, []string{"stopMessageLiveLocation"}, methods)
+}
@@ -0,0 +1,309 @@
+package ted
+
+import (
+	"errors"
+	"strconv"
+)
+
+// ErrNilMedia is returned when a request which sends media is missing its
+// InputMedia, or an InputMedia is missing the file to send.
+var ErrNilMedia = errors.New("ted: media must not be nil")
+
+// ErrUnsupportedMedia is returned when a request includes a kind of
+// InputMedia which cannot be sent with it, such as an InputMediaAnimation in
+// a media group.
+var ErrUnsupportedMedia = errors.New("ted: media of this type cannot be sent with this request")
+
+// InputMedia represents the content of a media message to be sent. It should
+// be one of:
+//
+//	InputMediaAnimation
+//	InputMediaDocument
+//	InputMediaAudio
+//	InputMediaPhoto
+//	InputMediaVideo
+//
+// New files given as a FileReader are uploaded along with the request using
+// attach:// references.
+type InputMedia interface {
+	inputMedia(a attachments) (interface{}, error)
+}
+
+// attachments collects the new files uploaded with a request which are
+// referred to from inside other parameters.
+type attachments map[string]FileReader
+
+// attach returns the value of a parameter referring to f. If f is a new file,
+// it is added to a and an attach:// reference to it is returned.
+func (a attachments) attach(f InputFile) string {
+	switch f := f.(type) {
+	case FileID:
+		return string(f)
+	case FileURL:
+		return string(f)
+	case FileReader:
+		name := "file" + strconv.Itoa(len(a))
+		a[name] = f
+		return "attach://" + name
+	}
+	return ""
+}
+
+// attachMedia is like attach, but for the file an InputMedia sends, which is
+// required. It returns ErrNilMedia if f is nil.
+func (a attachments) attachMedia(f InputFile) (string, error) {
+	if f == nil {
+		return "", ErrNilMedia
+	}
+	return a.attach(f), nil
+}
+
+// addTo adds the files in a to the parameters of a request made using
+// doUpload.
+func (a attachments) addTo(params map[string]interface{}) {
+	for name, f := range a {
+		params[name] = f
+	}
+}
+
+// InputMediaAnimation represents an animation file (GIF or H.264/MPEG-4 AVC
+// video without sound) to be sent.
+type InputMediaAnimation struct {
+	// File to send
+	Media InputFile
+
+	// Optional. Thumbnail of the file sent. The thumbnail should be in JPEG
+	// format and less than 200 kB in size. A thumbnail's width and height
+	// should not exceed 320. Thumbnails can only be uploaded as a new file.
+	Thumb InputFile
+
+	// Optional. Caption of the animation to be sent, 0-1024 characters after
+	// entities parsing
+	Caption string
+
+	// Optional. Mode for parsing entities in the animation caption.
+	ParseMode string
+
+	// Optional. Animation width
+	Width int
+
+	// Optional. Animation height
+	Height int
+
+	// Optional. Animation duration in seconds
+	Duration int
+}
+
+func (m InputMediaAnimation) inputMedia(a attachments) (interface{}, error) {
+	media, err := a.attachMedia(m.Media)
+	if err != nil {
+		return nil, err
+	}
+	return struct {
+		Type      string `json:"type"`
+		Media     string `json:"media"`
+		Thumb     string `json:"thumb,omitempty"`
+		Caption   string `json:"caption,omitempty"`
+		ParseMode string `json:"parse_mode,omitempty"`
+		Width     int    `json:"width,omitempty"`
+		Height    int    `json:"height,omitempty"`
+		Duration  int    `json:"duration,omitempty"`
+	}{
+		Type:      "animation",
+		Media:     media,
+		Thumb:     a.attach(m.Thumb),
+		Caption:   m.Caption,
+		ParseMode: m.ParseMode,
+		Width:     m.Width,
+		Height:    m.Height,
+		Duration:  m.Duration,
+	}, nil
+}
+
+// InputMediaPhoto represents a photo to be sent.
+type InputMediaPhoto struct {
+	// File to send
+	Media InputFile
+
+	// Optional. Caption of the photo to be sent, 0-1024 characters after
+	// entities parsing
+	Caption string
+
+	// Optional. Mode for parsing entities in the photo caption.
+	ParseMode string
+}
+
+func (m InputMediaPhoto) inputMedia(a attachments) (interface{}, error) {
+	media, err := a.attachMedia(m.Media)
+	if err != nil {
+		return nil, err
+	}
+	return struct {
+		Type      string `json:"type"`
+		Media     string `json:"media"`
+		Caption   string `json:"caption,omitempty"`
+		ParseMode string `json:"parse_mode,omitempty"`
+	}{
+		Type:      "photo",
+		Media:     media,
+		Caption:   m.Caption,
+		ParseMode: m.ParseMode,
+	}, nil
+}
+
+// InputMediaVideo represents a video to be sent.
+type InputMediaVideo struct {
+	// File to send
+	Media InputFile
+
+	// Optional. Thumbnail of the file sent. The thumbnail should be in JPEG
+	// format and less than 200 kB in size. A thumbnail's width and height
+	// should not exceed 320. Thumbnails can only be uploaded as a new file.
+	Thumb InputFile
+
+	// Optional. Caption of the video to be sent, 0-1024 characters after
+	// entities parsing
+	Caption string
+
+	// Optional. Mode for parsing entities in the video caption.
+	ParseMode string
+
+	// Optional. Video width
+	Width int
+
+	// Optional. Video height
+	Height int
+
+	// Optional. Video duration in seconds
+	Duration int
+
+	// Optional. Pass True, if the uploaded video is suitable for streaming
+	SupportsStreaming bool
+}
+
+func (m InputMediaVideo) inputMedia(a attachments) (interface{}, error) {
+	media, err := a.attachMedia(m.Media)
+	if err != nil {
+		return nil, err
+	}
+	return struct {
+		Type              string `json:"type"`
+		Media             string `json:"media"`
+		Thumb             string `json:"thumb,omitempty"`
+		Caption           string `json:"caption,omitempty"`
+		ParseMode         string `json:"parse_mode,omitempty"`
+		Width             int    `json:"width,omitempty"`
+		Height            int    `json:"height,omitempty"`
+		Duration          int    `json:"duration,omitempty"`
+		SupportsStreaming bool   `json:"supports_streaming,omitempty"`
+	}{
+		Type:              "video",
+		Media:             media,
+		Thumb:             a.attach(m.Thumb),
+		Caption:           m.Caption,
+		ParseMode:         m.ParseMode,
+		Width:             m.Width,
+		Height:            m.Height,
+		Duration:          m.Duration,
+		SupportsStreaming: m.SupportsStreaming,
+	}, nil
+}
+
+// InputMediaAudio represents an audio file to be treated as music to be
+// sent.
+type InputMediaAudio struct {
+	// File to send
+	Media InputFile
+
+	// Optional. Thumbnail of the file sent. The thumbnail should be in JPEG
+	// format and less than 200 kB in size. A thumbnail's width and height
+	// should not exceed 320. Thumbnails can only be uploaded as a new file.
+	Thumb InputFile
+
+	// Optional. Caption of the audio to be sent, 0-1024 characters after
+	// entities parsing
+	Caption string
+
+	// Optional. Mode for parsing entities in the audio caption.
+	ParseMode string
+
+	// Optional. Duration of the audio in seconds
+	Duration int
+
+	// Optional. Performer of the audio
+	Performer string
+
+	// Optional. Title of the audio
+	Title string
+}
+
+func (m InputMediaAudio) inputMedia(a attachments) (interface{}, error) {
+	media, err := a.attachMedia(m.Media)
+	if err != nil {
+		return nil, err
+	}
+	return struct {
+		Type      string `json:"type"`
+		Media     string `json:"media"`
+		Thumb     string `json:"thumb,omitempty"`
+		Caption   string `json:"caption,omitempty"`
+		ParseMode string `json:"parse_mode,omitempty"`
+		Duration  int    `json:"duration,omitempty"`
+		Performer string `json:"performer,omitempty"`
+		Title     string `json:"title,omitempty"`
+	}{
+		Type:      "audio",
+		Media:     media,
+		Thumb:     a.attach(m.Thumb),
+		Caption:   m.Caption,
+		ParseMode: m.ParseMode,
+		Duration:  m.Duration,
+		Performer: m.Performer,
+		Title:     m.Title,
+	}, nil
+}
+
+// InputMediaDocument represents a general file to be sent.
+type InputMediaDocument struct {
+	// File to send
+	Media InputFile
+
+	// Optional. Thumbnail of the file sent. The thumbnail should be in JPEG
+	// format and less than 200 kB in size. A thumbnail's width and height
+	// should not exceed 320. Thumbnails can only be uploaded as a new file.
+	Thumb InputFile
+
+	// Optional. Caption of the document to be sent, 0-1024 characters after
+	// entities parsing
+	Caption string
+
+	// Optional. Mode for parsing entities in the document caption.
+	ParseMode string
+
+	// Optional. Disables automatic server-side content type detection for
+	// files uploaded using multipart/form-data. Always True, if the document
+	// is sent as part of an album.
+	DisableContentTypeDetection bool
+}
+
+func (m InputMediaDocument) inputMedia(a attachments) (interface{}, error) {
+	media, err := a.attachMedia(m.Media)
+	if err != nil {
+		return nil, err
+	}
+	return struct {
+		Type                        string `json:"type"`
+		Media                       string `json:"media"`
+		Thumb                       string `json:"thumb,omitempty"`
+		Caption                     string `json:"caption,omitempty"`
+		ParseMode                   string `json:"parse_mode,omitempty"`
+		DisableContentTypeDetection bool   `json:"disable_content_type_detection,omitempty"`
+	}{
+		Type:                        "document",
+		Media:                       media,
+		Thumb:                       a.attach(m.Thumb),
+		Caption:                     m.Caption,
+		ParseMode:                   m.ParseMode,
+		DisableContentTypeDetection: m.DisableContentTypeDetection,
+	}, nil
+}
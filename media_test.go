@@ -0,0 +1,166 @@
+package ted
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEditMessageMediaRequest(t *testing.T) {
+	t.Run("uploads new files as attachments", func(t *testing.T) {
+		var media map[string]interface{}
+		files := make(map[string]string)
+		bot := Bot{
+			Token: "TOKEN",
+			HTTPClient: clientFunc(func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "https://api.telegram.org/botTOKEN/editMessageMedia", req.URL.String())
+				assert.NoError(t, req.ParseMultipartForm(1<<20))
+				assert.Equal(t, "123", req.FormValue("chat_id"))
+				assert.Equal(t, "456", req.FormValue("message_id"))
+				assert.NoError(t, json.Unmarshal([]byte(req.FormValue("media")), &media))
+				for name, headers := range req.MultipartForm.File {
+					f, err := headers[0].Open()
+					assert.NoError(t, err)
+					data, _ := ioutil.ReadAll(f)
+					files[name] = string(data)
+				}
+				return &http.Response{Body: ioutil.NopCloser(strings.NewReader(`{"ok":true,"result":{"message_id":456}}`))}, nil
+			}),
+		}
+		message, err := bot.EditMessageMedia(context.Background(), EditMessageMediaRequest{
+			ChatID:    123,
+			MessageID: 456,
+			Media: InputMediaAnimation{
+				Media:   FileReader{Name: "animation.mp4", Reader: strings.NewReader("animation")},
+				Thumb:   FileReader{Name: "thumb.jpg", Reader: strings.NewReader("thumb")},
+				Caption: "Caption",
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 456, message.ID)
+		assert.Equal(t, map[string]interface{}{
+			"type":    "animation",
+			"media":   "attach://file0",
+			"thumb":   "attach://file1",
+			"caption": "Caption",
+		}, media)
+		assert.Equal(t, map[string]string{"file0": "animation", "file1": "thumb"}, files)
+	})
+	t.Run("refers to existing files directly", func(t *testing.T) {
+		var body map[string]interface{}
+		bot := Bot{
+			HTTPClient: clientFunc(func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+				return &http.Response{Body: ioutil.NopCloser(strings.NewReader(`{"ok":true,"result":true}`))}, nil
+			}),
+		}
+		message, err := bot.EditMessageMedia(context.Background(), EditMessageMediaRequest{
+			InlineMessageID: "abc",
+			Media:           InputMediaAnimation{Media: FileID("xyz")},
+		})
+		assert.NoError(t, err)
+		assert.Nil(t, message)
+		assert.Equal(t, map[string]interface{}{
+			"inline_message_id": "abc",
+			"media": map[string]interface{}{
+				"type":  "animation",
+				"media": "xyz",
+			},
+		}, body)
+	})
+}
+
+func TestEditMessageMediaRequest_nilMedia(t *testing.T) {
+	client := &httpClient{}
+	bot := Bot{HTTPClient: client}
+	_, err := bot.EditMessageMedia(context.Background(), EditMessageMediaRequest{ChatID: 123, MessageID: 456})
+	assert.Equal(t, ErrNilMedia, err)
+	assert.Empty(t, client.requests)
+	_, err = bot.EditMessageMedia(context.Background(), EditMessageMediaRequest{ChatID: 123, MessageID: 456, Media: InputMediaPhoto{}})
+	assert.Equal(t, ErrNilMedia, err)
+	assert.Empty(t, client.requests)
+}
+
+func TestSendMediaGroupRequest(t *testing.T) {
+	var media []map[string]interface{}
+	files := make(map[string]string)
+	bot := Bot{
+		Token: "TOKEN",
+		HTTPClient: clientFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "https://api.telegram.org/botTOKEN/sendMediaGroup", req.URL.String())
+			assert.NoError(t, req.ParseMultipartForm(1<<20))
+			assert.Equal(t, "123", req.FormValue("chat_id"))
+			assert.NoError(t, json.Unmarshal([]byte(req.FormValue("media")), &media))
+			for name, headers := range req.MultipartForm.File {
+				f, err := headers[0].Open()
+				assert.NoError(t, err)
+				data, _ := ioutil.ReadAll(f)
+				files[name] = string(data)
+			}
+			return &http.Response{Body: ioutil.NopCloser(strings.NewReader(`{"ok":true,"result":[{"message_id":1,"media_group_id":"g"},{"message_id":2,"media_group_id":"g"},{"message_id":3,"media_group_id":"g"}]}`))}, nil
+		}),
+	}
+	messages, err := bot.SendMediaGroup(context.Background(), SendMediaGroupRequest{
+		ChatID: 123,
+		Media: []InputMedia{
+			InputMediaPhoto{Media: FileID("abc"), Caption: "Digest"},
+			InputMediaPhoto{Media: FileReader{Name: "1.jpg", Reader: strings.NewReader("one")}},
+			InputMediaPhoto{Media: FileReader{Name: "2.jpg", Reader: strings.NewReader("two")}},
+		},
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, messages, 3) {
+		assert.Equal(t, 3, messages[2].ID)
+	}
+	assert.Equal(t, []map[string]interface{}{
+		{"type": "photo", "media": "abc", "caption": "Digest"},
+		{"type": "photo", "media": "attach://file0"},
+		{"type": "photo", "media": "attach://file1"},
+	}, media)
+	assert.Equal(t, map[string]string{"file0": "one", "file1": "two"}, files)
+}
+
+func TestSendMediaGroupRequest_invalidMedia(t *testing.T) {
+	tests := []struct {
+		name  string
+		media []InputMedia
+		err   error
+		msg   string
+	}{
+		{
+			name:  "nil",
+			media: []InputMedia{InputMediaPhoto{Media: FileID("abc")}, nil},
+			err:   ErrNilMedia,
+			msg:   "ted: media must not be nil: item 1",
+		},
+		{
+			name:  "nil file",
+			media: []InputMedia{InputMediaPhoto{Media: FileID("abc")}, InputMediaVideo{Thumb: FileID("def")}},
+			err:   ErrNilMedia,
+			msg:   "ted: media must not be nil: item 1",
+		},
+		{
+			name:  "animation",
+			media: []InputMedia{InputMediaAnimation{Media: FileID("abc")}, InputMediaPhoto{Media: FileID("def")}},
+			err:   ErrUnsupportedMedia,
+			msg:   "ted: media of this type cannot be sent with this request: item 0 is ted.InputMediaAnimation",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &httpClient{}
+			bot := Bot{HTTPClient: client}
+			_, err := bot.SendMediaGroup(context.Background(), SendMediaGroupRequest{ChatID: 123, Media: tt.media})
+			assert.True(t, errors.Is(err, tt.err))
+			assert.EqualError(t, err, tt.msg)
+			assert.Empty(t, client.requests)
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package ted
+
+import (
+	"encoding/json"
+)
+
+// ChatMember contains information about one member of a chat. It is one of:
+//
+//	ChatMemberOwner
+//	ChatMemberAdministrator
+//	ChatMemberMember
+//	ChatMemberRestricted
+//	ChatMemberLeft
+//	ChatMemberBanned
+//	ChatMemberUnknown
+type ChatMember interface {
+	// Status returns the member's status in the chat, which is one of
+	// "creator", "administrator", "member", "restricted", "left" or
+	// "kicked", or any other status returned by Telegram for a
+	// ChatMemberUnknown.
+	Status() string
+
+	// Member returns information about the user.
+	Member() User
+}
+
+// ChatMemberOwner represents a chat member that owns the chat and has all
+// administrator privileges.
+type ChatMemberOwner struct {
+	User        User   `json:"user"`         // Information about the user
+	IsAnonymous bool   `json:"is_anonymous"` // True, if the user's presence in the chat is hidden
+	CustomTitle string `json:"custom_title"` // Optional. Custom title for this user
+}
+
+func (m ChatMemberOwner) Status() string { return "creator" }
+func (m ChatMemberOwner) Member() User   { return m.User }
+
+// ChatMemberAdministrator represents a chat member that has some additional
+// privileges.
+type ChatMemberAdministrator struct {
+	// Information about the user
+	User User `json:"user"`
+
+	// True, if the bot is allowed to edit administrator privileges of that user
+	CanBeEdited bool `json:"can_be_edited"`
+
+	// True, if the user's presence in the chat is hidden
+	IsAnonymous bool `json:"is_anonymous"`
+
+	// True, if the administrator can access the chat event log, chat statistics, message statistics in channels, see channel members, see anonymous administrators in supergroups and ignore slow mode. Implied by any other administrator privilege
+	CanManageChat bool `json:"can_manage_chat"`
+
+	// True, if the administrator can delete messages of other users
+	CanDeleteMessages bool `json:"can_delete_messages"`
+
+	// True, if the administrator can manage voice chats
+	CanManageVoiceChats bool `json:"can_manage_voice_chats"`
+
+	// True, if the administrator can restrict, ban or unban chat members
+	CanRestrictMembers bool `json:"can_restrict_members"`
+
+	// True, if the administrator can add new administrators with a subset of their own privileges or demote administrators that he has promoted, directly or indirectly (promoted by administrators that were appointed by the user)
+	CanPromoteMembers bool `json:"can_promote_members"`
+
+	// True, if the user is allowed to change the chat title, photo and other settings
+	CanChangeInfo bool `json:"can_change_info"`
+
+	// True, if the user is allowed to invite new users to the chat
+	CanInviteUsers bool `json:"can_invite_users"`
+
+	// Optional. True, if the administrator can post in the channel; channels only
+	CanPostMessages bool `json:"can_post_messages"`
+
+	// Optional. True, if the administrator can edit messages of other users and can pin messages; channels only
+	CanEditMessages bool `json:"can_edit_messages"`
+
+	// Optional. True, if the user is allowed to pin messages; groups and supergroups only
+	CanPinMessages bool `json:"can_pin_messages"`
+
+	// Optional. Custom title for this user
+	CustomTitle string `json:"custom_title"`
+}
+
+func (m ChatMemberAdministrator) Status() string { return "administrator" }
+func (m ChatMemberAdministrator) Member() User   { return m.User }
+
+// ChatMemberMember represents a chat member that has no additional privileges
+// or restrictions.
+type ChatMemberMember struct {
+	User User `json:"user"` // Information about the user
+}
+
+func (m ChatMemberMember) Status() string { return "member" }
+func (m ChatMemberMember) Member() User   { return m.User }
+
+// ChatMemberRestricted represents a chat member that is under certain
+// restrictions in the chat. Supergroups only.
+type ChatMemberRestricted struct {
+	// Information about the user
+	User User `json:"user"`
+
+	// True, if the user is a member of the chat at the moment of the request
+	IsMember bool `json:"is_member"`
+
+	// True, if the user is allowed to change the chat title, photo and other settings
+	CanChangeInfo bool `json:"can_change_info"`
+
+	// True, if the user is allowed to invite new users to the chat
+	CanInviteUsers bool `json:"can_invite_users"`
+
+	// True, if the user is allowed to pin messages
+	CanPinMessages bool `json:"can_pin_messages"`
+
+	// True, if the user is allowed to send text messages, contacts, locations and venues
+	CanSendMessages bool `json:"can_send_messages"`
+
+	// True, if the user is allowed to send audios, documents, photos, videos, video notes and voice notes
+	CanSendMediaMessages bool `json:"can_send_media_messages"`
+
+	// True, if the user is allowed to send polls
+	CanSendPolls bool `json:"can_send_polls"`
+
+	// True, if the user is allowed to send animations, games, stickers and use inline bots
+	CanSendOtherMessages bool `json:"can_send_other_messages"`
+
+	// True, if the user is allowed to add web page previews to their messages
+	CanAddWebPagePreviews bool `json:"can_add_web_page_previews"`
+
+	// Date when restrictions will be lifted for this user; unix time. If 0, then the user is restricted forever
+	UntilDate int `json:"until_date"`
+}
+
+func (m ChatMemberRestricted) Status() string { return "restricted" }
+func (m ChatMemberRestricted) Member() User   { return m.User }
+
+// ChatMemberLeft represents a chat member that isn't currently a member of
+// the chat, but may join it themselves.
+type ChatMemberLeft struct {
+	User User `json:"user"` // Information about the user
+}
+
+func (m ChatMemberLeft) Status() string { return "left" }
+func (m ChatMemberLeft) Member() User   { return m.User }
+
+// ChatMemberBanned represents a chat member that was banned in the chat and
+// can't return to the chat or view chat messages.
+type ChatMemberBanned struct {
+	User      User `json:"user"`       // Information about the user
+	UntilDate int  `json:"until_date"` // Date when restrictions will be lifted for this user; unix time. If 0, then the user is banned forever
+}
+
+func (m ChatMemberBanned) Status() string { return "kicked" }
+func (m ChatMemberBanned) Member() User   { return m.User }
+
+// ChatMemberUnknown represents a chat member with a status which is not known
+// to this package, such as one added in a newer version of the Bot API, or a
+// chat member which was missing.
+type ChatMemberUnknown struct {
+	User      User            `json:"user"`   // Information about the user
+	RawStatus string          `json:"status"` // The member's status in the chat
+	Raw       json.RawMessage `json:"-"`      // The chat member as returned by Telegram
+}
+
+func (m ChatMemberUnknown) Status() string { return m.RawStatus }
+func (m ChatMemberUnknown) Member() User   { return m.User }
+
+// unmarshalChatMember decodes a ChatMember into the type corresponding to its
+// status. Chat members which are missing or have an unknown status
+// are decoded into a ChatMemberUnknown.
+func unmarshalChatMember(data []byte) (ChatMember, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return ChatMemberUnknown{}, nil
+	}
+	var member struct {
+		Status string `json:"status"`
+	}
+	err := json.Unmarshal(data, &member)
+	if err != nil {
+		return nil, err
+	}
+	switch member.Status {
+	case "creator":
+		var m ChatMemberOwner
+		err = json.Unmarshal(data, &m)
+		return m, err
+	case "administrator":
+		var m ChatMemberAdministrator
+		err = json.Unmarshal(data, &m)
+		return m, err
+	case "member":
+		var m ChatMemberMember
+		err = json.Unmarshal(data, &m)
+		return m, err
+	case "restricted":
+		var m ChatMemberRestricted
+		err = json.Unmarshal(data, &m)
+		return m, err
+	case "left":
+		var m ChatMemberLeft
+		err = json.Unmarshal(data, &m)
+		return m, err
+	case "kicked":
+		var m ChatMemberBanned
+		err = json.Unmarshal(data, &m)
+		return m, err
+	}
+	m := ChatMemberUnknown{Raw: append(json.RawMessage(nil), data...)}
+	err = json.Unmarshal(data, &m)
+	return m, err
+}
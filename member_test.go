@@ -0,0 +1,106 @@
+package ted
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBot_GetChatAdministrators(t *testing.T) {
+	client := &httpClient{
+		results: []result{
+			okResponse(`[
+  {"user": {"id": 1, "is_bot": false, "first_name": "Owner"}, "status": "creator", "is_anonymous": false, "custom_title": "Boss"},
+  {"user": {"id": 2, "is_bot": false, "first_name": "Admin"}, "status": "administrator", "can_be_edited": true, "can_manage_chat": true, "can_delete_messages": true, "can_restrict_members": true, "is_anonymous": false}
+]`),
+		},
+	}
+	bot := Bot{HTTPClient: client}
+	members, err := bot.GetChatAdministrators(context.Background(), GetChatAdministratorsRequest{ChatID: -1001234})
+	assert.NoError(t, err)
+	assert.Equal(t, []ChatMember{
+		ChatMemberOwner{User: User{ID: 1, FirstName: "Owner"}, CustomTitle: "Boss"},
+		ChatMemberAdministrator{User: User{ID: 2, FirstName: "Admin"}, CanBeEdited: true, CanManageChat: true, CanDeleteMessages: true, CanRestrictMembers: true},
+	}, members)
+	assert.Equal(t, "creator", members[0].Status())
+	assert.Equal(t, "Admin", members[1].Member().FirstName)
+}
+
+func TestBot_GetChatMember(t *testing.T) {
+	client := &httpClient{
+		results: []result{
+			okResponse(`{"user": {"id": 3, "is_bot": false, "first_name": "Jane"}, "status": "restricted", "is_member": true, "can_send_messages": true, "until_date": 1600000000}`),
+		},
+	}
+	bot := Bot{HTTPClient: client}
+	member, err := bot.GetChatMember(context.Background(), GetChatMemberRequest{ChatID: -1001234, UserID: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, ChatMemberRestricted{User: User{ID: 3, FirstName: "Jane"}, IsMember: true, CanSendMessages: true, UntilDate: 1600000000}, member)
+}
+
+func TestChatMemberUpdated_UnmarshalJSON(t *testing.T) {
+	payload := `{
+  "chat": {"id": -1001234, "title": "Group", "type": "supergroup"},
+  "from": {"id": 1, "is_bot": false, "first_name": "Owner"},
+  "date": 1600000000,
+  "old_chat_member": {"user": {"id": 3, "is_bot": false, "first_name": "Jane"}, "status": "member"},
+  "new_chat_member": {"user": {"id": 3, "is_bot": false, "first_name": "Jane"}, "status": "kicked", "until_date": 0}
+}`
+	var updated ChatMemberUpdated
+	err := json.Unmarshal([]byte(payload), &updated)
+	assert.NoError(t, err)
+	assert.Equal(t, "Group", updated.Chat.Title)
+	assert.Equal(t, ChatMemberMember{User: User{ID: 3, FirstName: "Jane"}}, updated.OldChatMember)
+	assert.Equal(t, ChatMemberBanned{User: User{ID: 3, FirstName: "Jane"}}, updated.NewChatMember)
+}
+
+func TestChatMemberUpdated_UnmarshalJSON_Unknown(t *testing.T) {
+	payload := `{
+  "chat": {"id": -1001234, "title": "Group", "type": "supergroup"},
+  "from": {"id": 1, "is_bot": false, "first_name": "Owner"},
+  "date": 1600000000,
+  "new_chat_member": {"user": {"id": 3, "is_bot": false, "first_name": "Jane"}, "status": "probation", "until_date": 1600001000}
+}`
+	var updated ChatMemberUpdated
+	err := json.Unmarshal([]byte(payload), &updated)
+	assert.NoError(t, err)
+	assert.Equal(t, ChatMemberUnknown{}, updated.OldChatMember)
+	assert.Equal(t, "", updated.OldChatMember.Status())
+	member, ok := updated.NewChatMember.(ChatMemberUnknown)
+	if assert.True(t, ok) {
+		assert.Equal(t, "probation", member.Status())
+		assert.Equal(t, User{ID: 3, FirstName: "Jane"}, member.Member())
+		assert.JSONEq(t, `{"user": {"id": 3, "is_bot": false, "first_name": "Jane"}, "status": "probation", "until_date": 1600001000}`, string(member.Raw))
+	}
+}
+
+func TestChat_UnmarshalJSON(t *testing.T) {
+	payload := `{
+  "id": -1001234,
+  "title": "Group",
+  "username": "group",
+  "type": "supergroup",
+  "description": "A group",
+  "invite_link": "https://t.me/+abc",
+  "pinned_message": {"message_id": 5, "chat": {"id": -1001234, "type": "supergroup"}, "date": 1600000000, "text": "Rules"},
+  "permissions": {"can_send_messages": true, "can_send_media_messages": true, "can_send_polls": false, "can_send_other_messages": true, "can_add_web_page_previews": true, "can_change_info": false, "can_invite_users": true, "can_pin_messages": false},
+  "slow_mode_delay": 30,
+  "linked_chat_id": -1005678
+}`
+	var chat Chat
+	err := json.Unmarshal([]byte(payload), &chat)
+	assert.NoError(t, err)
+	assert.Equal(t, "A group", chat.Description)
+	assert.Equal(t, "Rules", chat.PinnedMessage.Text)
+	assert.Equal(t, &ChatPermissions{
+		CanSendMessages:       true,
+		CanSendMediaMessages:  true,
+		CanSendOtherMessages:  true,
+		CanAddWebPagePreviews: true,
+		CanInviteUsers:        true,
+	}, chat.Permissions)
+	assert.Equal(t, 30, chat.SlowModeDelay)
+	assert.Equal(t, int64(-1005678), chat.LinkedChatID)
+}
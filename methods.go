@@ -1,50 +1,396 @@
 package ted
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 )
 
-func (b Bot) GetMe() (User, error) {
-	req := GetMeRequest{}
-	res, err := b.Do(req)
+// Call makes request with the provided context and decodes its result into a
+// value of type T, which should be the type of the result Telegram returns
+// for the request. It can be used to get typed results for requests without
+// a typed method on Bot, for example:
+//
+//	message, err := ted.Call[ted.Message](ctx, bot, req)
+func Call[T any](ctx context.Context, bot Bot, request Request) (T, error) {
+	var result T
+	res, err := bot.DoContext(ctx, request)
 	if err != nil {
-		return User{}, err
+		return result, err
 	}
-	var me User
-	err = json.Unmarshal(res.Result, &me)
+	err = json.Unmarshal(res.Result, &result)
+	return result, err
+}
+
+// callEdit makes a request which edits a message and returns the edited
+// Message, or nil if the edited message was an inline message, in which case
+// Telegram only returns True.
+func callEdit(ctx context.Context, bot Bot, request Request) (*Message, error) {
+	res, err := bot.DoContext(ctx, request)
 	if err != nil {
-		return User{}, err
+		return nil, err
+	}
+	if bytes.Equal(res.Result, []byte("true")) {
+		return nil, nil
 	}
-	return me, nil
+	var message Message
+	err = json.Unmarshal(res.Result, &message)
+	if err != nil {
+		return nil, err
+	}
+	return &message, nil
+}
+
+func (b Bot) GetMe() (User, error) {
+	return b.GetMeContext(context.Background())
+}
+
+// GetMeContext is like GetMe but uses the provided context.
+func (b Bot) GetMeContext(ctx context.Context) (User, error) {
+	return Call[User](ctx, b, GetMeRequest{})
 }
 
 // GetWebhookInfo returns the current webhook status. Requires no parameters.
 // On success, returns a WebhookInfo object. If the bot is using getUpdates,
 // will return an object with the url field empty.
 func (b Bot) GetWebhookInfo() (WebhookInfo, error) {
-	req := GetWebhookInfoRequest{}
-	res, err := b.Do(req)
-	if err != nil {
-		return WebhookInfo{}, err
-	}
-	var info WebhookInfo
-	err = json.Unmarshal(res.Result, &info)
-	if err != nil {
-		return WebhookInfo{}, err
-	}
-	return info, nil
+	return b.GetWebhookInfoContext(context.Background())
+}
+
+// GetWebhookInfoContext is like GetWebhookInfo but uses the provided context.
+func (b Bot) GetWebhookInfoContext(ctx context.Context) (WebhookInfo, error) {
+	return Call[WebhookInfo](ctx, b, GetWebhookInfoRequest{})
 }
 
 func (b Bot) GetMyCommands() ([]BotCommand, error) {
-	req := GetMyCommandsRequest{}
-	res, err := b.Do(req)
+	return b.GetMyCommandsContext(context.Background())
+}
+
+// GetMyCommandsContext is like GetMyCommands but uses the provided context.
+func (b Bot) GetMyCommandsContext(ctx context.Context) ([]BotCommand, error) {
+	return Call[[]BotCommand](ctx, b, GetMyCommandsRequest{})
+}
+
+// SetWebhook makes a SetWebhookRequest. Returns True on success.
+func (b Bot) SetWebhook(ctx context.Context, req SetWebhookRequest) (bool, error) {
+	return Call[bool](ctx, b, req)
+}
+
+// GetUpdates makes a GetUpdatesRequest and returns the updates received.
+func (b Bot) GetUpdates(ctx context.Context, req GetUpdatesRequest) ([]Update, error) {
+	return Call[[]Update](ctx, b, req)
+}
+
+// SetMyCommands makes a SetMyCommandsRequest. Returns True on success.
+func (b Bot) SetMyCommands(ctx context.Context, req SetMyCommandsRequest) (bool, error) {
+	return Call[bool](ctx, b, req)
+}
+
+// LogOut makes a LogOutRequest. Returns True on success.
+func (b Bot) LogOut(ctx context.Context) (bool, error) {
+	return Call[bool](ctx, b, LogOutRequest{})
+}
+
+// Close makes a CloseRequest. Returns True on success.
+func (b Bot) Close(ctx context.Context) (bool, error) {
+	return Call[bool](ctx, b, CloseRequest{})
+}
+
+// SendMessage makes a SendMessageRequest and returns the sent Message.
+func (b Bot) SendMessage(ctx context.Context, req SendMessageRequest) (Message, error) {
+	return Call[Message](ctx, b, req)
+}
+
+// SendPhoto makes a SendPhotoRequest and returns the sent Message.
+func (b Bot) SendPhoto(ctx context.Context, req SendPhotoRequest) (Message, error) {
+	return Call[Message](ctx, b, req)
+}
+
+// SendAudio makes a SendAudioRequest and returns the sent Message.
+func (b Bot) SendAudio(ctx context.Context, req SendAudioRequest) (Message, error) {
+	return Call[Message](ctx, b, req)
+}
+
+// SendDocument makes a SendDocumentRequest and returns the sent Message.
+func (b Bot) SendDocument(ctx context.Context, req SendDocumentRequest) (Message, error) {
+	return Call[Message](ctx, b, req)
+}
+
+// SendVideo makes a SendVideoRequest and returns the sent Message.
+func (b Bot) SendVideo(ctx context.Context, req SendVideoRequest) (Message, error) {
+	return Call[Message](ctx, b, req)
+}
+
+// SendAnimation makes a SendAnimationRequest and returns the sent Message.
+func (b Bot) SendAnimation(ctx context.Context, req SendAnimationRequest) (Message, error) {
+	return Call[Message](ctx, b, req)
+}
+
+// SendVoice makes a SendVoiceRequest and returns the sent Message.
+func (b Bot) SendVoice(ctx context.Context, req SendVoiceRequest) (Message, error) {
+	return Call[Message](ctx, b, req)
+}
+
+// SendLocation makes a SendLocationRequest and returns the sent Message.
+func (b Bot) SendLocation(ctx context.Context, req SendLocationRequest) (Message, error) {
+	return Call[Message](ctx, b, req)
+}
+
+// SendVenue makes a SendVenueRequest and returns the sent Message.
+func (b Bot) SendVenue(ctx context.Context, req SendVenueRequest) (Message, error) {
+	return Call[Message](ctx, b, req)
+}
+
+// GetFile makes a GetFileRequest and returns the File, which can be
+// downloaded using DownloadFile.
+func (b Bot) GetFile(ctx context.Context, req GetFileRequest) (File, error) {
+	return Call[File](ctx, b, req)
+}
+
+// EditMessageText makes an EditMessageTextRequest. If the edited message was
+// sent by the bot, the edited Message is returned, otherwise the returned
+// Message is nil.
+func (b Bot) EditMessageText(ctx context.Context, req EditMessageTextRequest) (*Message, error) {
+	return callEdit(ctx, b, req)
+}
+
+// EditMessageReplyMarkup makes an EditMessageReplyMarkupRequest. If the
+// edited message was sent by the bot, the edited Message is returned,
+// otherwise the returned Message is nil.
+func (b Bot) EditMessageReplyMarkup(ctx context.Context, req EditMessageReplyMarkupRequest) (*Message, error) {
+	return callEdit(ctx, b, req)
+}
+
+// AnswerCallbackQuery makes an AnswerCallbackQueryRequest. Returns True on
+// success.
+func (b Bot) AnswerCallbackQuery(ctx context.Context, req AnswerCallbackQueryRequest) (bool, error) {
+	return Call[bool](ctx, b, req)
+}
+
+// AnswerInlineQuery makes an AnswerInlineQueryRequest. Returns True on
+// success.
+func (b Bot) AnswerInlineQuery(ctx context.Context, req AnswerInlineQueryRequest) (bool, error) {
+	return Call[bool](ctx, b, req)
+}
+
+// GetChat makes a GetChatRequest and returns the Chat.
+func (b Bot) GetChat(ctx context.Context, req GetChatRequest) (Chat, error) {
+	return Call[Chat](ctx, b, req)
+}
+
+// GetChatAdministrators makes a GetChatAdministratorsRequest and returns the
+// administrators of the chat.
+func (b Bot) GetChatAdministrators(ctx context.Context, req GetChatAdministratorsRequest) ([]ChatMember, error) {
+	data, err := Call[[]json.RawMessage](ctx, b, req)
 	if err != nil {
 		return nil, err
 	}
-	var commands []BotCommand
-	err = json.Unmarshal(res.Result, &commands)
+	members := make([]ChatMember, len(data))
+	for i := range data {
+		members[i], err = unmarshalChatMember(data[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return members, nil
+}
+
+// GetChatMemberCount makes a GetChatMemberCountRequest and returns the number
+// of members in the chat.
+func (b Bot) GetChatMemberCount(ctx context.Context, req GetChatMemberCountRequest) (int, error) {
+	return Call[int](ctx, b, req)
+}
+
+// GetChatMember makes a GetChatMemberRequest and returns the ChatMember.
+func (b Bot) GetChatMember(ctx context.Context, req GetChatMemberRequest) (ChatMember, error) {
+	data, err := Call[json.RawMessage](ctx, b, req)
 	if err != nil {
 		return nil, err
 	}
-	return commands, nil
+	return unmarshalChatMember(data)
+}
+
+// BanChatMember makes a BanChatMemberRequest. Returns True on success.
+func (b Bot) BanChatMember(ctx context.Context, req BanChatMemberRequest) (bool, error) {
+	return Call[bool](ctx, b, req)
+}
+
+// UnbanChatMember makes an UnbanChatMemberRequest. Returns True on success.
+func (b Bot) UnbanChatMember(ctx context.Context, req UnbanChatMemberRequest) (bool, error) {
+	return Call[bool](ctx, b, req)
+}
+
+// RestrictChatMember makes a RestrictChatMemberRequest. Returns True on
+// success.
+func (b Bot) RestrictChatMember(ctx context.Context, req RestrictChatMemberRequest) (bool, error) {
+	return Call[bool](ctx, b, req)
+}
+
+// PromoteChatMember makes a PromoteChatMemberRequest. Returns True on success.
+func (b Bot) PromoteChatMember(ctx context.Context, req PromoteChatMemberRequest) (bool, error) {
+	return Call[bool](ctx, b, req)
+}
+
+// SetChatAdministratorCustomTitle makes a
+// SetChatAdministratorCustomTitleRequest. Returns True on success.
+func (b Bot) SetChatAdministratorCustomTitle(ctx context.Context, req SetChatAdministratorCustomTitleRequest) (bool, error) {
+	return Call[bool](ctx, b, req)
+}
+
+// SetChatPermissions makes a SetChatPermissionsRequest. Returns True on
+// success.
+func (b Bot) SetChatPermissions(ctx context.Context, req SetChatPermissionsRequest) (bool, error) {
+	return Call[bool](ctx, b, req)
+}
+
+// SetChatTitle makes a SetChatTitleRequest. Returns True on success.
+func (b Bot) SetChatTitle(ctx context.Context, req SetChatTitleRequest) (bool, error) {
+	return Call[bool](ctx, b, req)
+}
+
+// SetChatDescription makes a SetChatDescriptionRequest. Returns True on
+// success.
+func (b Bot) SetChatDescription(ctx context.Context, req SetChatDescriptionRequest) (bool, error) {
+	return Call[bool](ctx, b, req)
+}
+
+// SetChatPhoto makes a SetChatPhotoRequest. Returns True on success.
+func (b Bot) SetChatPhoto(ctx context.Context, req SetChatPhotoRequest) (bool, error) {
+	return Call[bool](ctx, b, req)
+}
+
+// DeleteChatPhoto makes a DeleteChatPhotoRequest. Returns True on success.
+func (b Bot) DeleteChatPhoto(ctx context.Context, req DeleteChatPhotoRequest) (bool, error) {
+	return Call[bool](ctx, b, req)
+}
+
+// PinChatMessage makes a PinChatMessageRequest. Returns True on success.
+func (b Bot) PinChatMessage(ctx context.Context, req PinChatMessageRequest) (bool, error) {
+	return Call[bool](ctx, b, req)
+}
+
+// UnpinChatMessage makes an UnpinChatMessageRequest. Returns True on success.
+func (b Bot) UnpinChatMessage(ctx context.Context, req UnpinChatMessageRequest) (bool, error) {
+	return Call[bool](ctx, b, req)
+}
+
+// UnpinAllChatMessages makes an UnpinAllChatMessagesRequest. Returns True on
+// success.
+func (b Bot) UnpinAllChatMessages(ctx context.Context, req UnpinAllChatMessagesRequest) (bool, error) {
+	return Call[bool](ctx, b, req)
+}
+
+// LeaveChat makes a LeaveChatRequest. Returns True on success.
+func (b Bot) LeaveChat(ctx context.Context, req LeaveChatRequest) (bool, error) {
+	return Call[bool](ctx, b, req)
+}
+
+// ExportChatInviteLink makes an ExportChatInviteLinkRequest and returns the
+// new invite link.
+func (b Bot) ExportChatInviteLink(ctx context.Context, req ExportChatInviteLinkRequest) (string, error) {
+	return Call[string](ctx, b, req)
+}
+
+// CreateChatInviteLink makes a CreateChatInviteLinkRequest and returns the
+// new invite link.
+func (b Bot) CreateChatInviteLink(ctx context.Context, req CreateChatInviteLinkRequest) (ChatInviteLink, error) {
+	return Call[ChatInviteLink](ctx, b, req)
+}
+
+// EditChatInviteLink makes an EditChatInviteLinkRequest and returns the
+// edited invite link.
+func (b Bot) EditChatInviteLink(ctx context.Context, req EditChatInviteLinkRequest) (ChatInviteLink, error) {
+	return Call[ChatInviteLink](ctx, b, req)
+}
+
+// RevokeChatInviteLink makes a RevokeChatInviteLinkRequest and returns the
+// revoked invite link.
+func (b Bot) RevokeChatInviteLink(ctx context.Context, req RevokeChatInviteLinkRequest) (ChatInviteLink, error) {
+	return Call[ChatInviteLink](ctx, b, req)
+}
+
+// ApproveChatJoinRequest makes an ApproveChatJoinRequest. Returns True on
+// success.
+func (b Bot) ApproveChatJoinRequest(ctx context.Context, req ApproveChatJoinRequest) (bool, error) {
+	return Call[bool](ctx, b, req)
+}
+
+// DeclineChatJoinRequest makes a DeclineChatJoinRequest. Returns True on
+// success.
+func (b Bot) DeclineChatJoinRequest(ctx context.Context, req DeclineChatJoinRequest) (bool, error) {
+	return Call[bool](ctx, b, req)
+}
+
+// ForwardMessage makes a ForwardMessageRequest and returns the sent Message.
+func (b Bot) ForwardMessage(ctx context.Context, req ForwardMessageRequest) (Message, error) {
+	return Call[Message](ctx, b, req)
+}
+
+// CopyMessage makes a CopyMessageRequest and returns the MessageID of the
+// sent message.
+func (b Bot) CopyMessage(ctx context.Context, req CopyMessageRequest) (MessageID, error) {
+	return Call[MessageID](ctx, b, req)
+}
+
+// DeleteMessage makes a DeleteMessageRequest. Returns True on success.
+func (b Bot) DeleteMessage(ctx context.Context, req DeleteMessageRequest) (bool, error) {
+	return Call[bool](ctx, b, req)
+}
+
+// EditMessageCaption makes an EditMessageCaptionRequest. If the edited
+// message was sent by the bot, the edited Message is returned, otherwise the
+// returned Message is nil.
+func (b Bot) EditMessageCaption(ctx context.Context, req EditMessageCaptionRequest) (*Message, error) {
+	return callEdit(ctx, b, req)
+}
+
+// EditMessageMedia makes an EditMessageMediaRequest. If the edited message
+// was sent by the bot, the edited Message is returned, otherwise the returned
+// Message is nil.
+func (b Bot) EditMessageMedia(ctx context.Context, req EditMessageMediaRequest) (*Message, error) {
+	return callEdit(ctx, b, req)
+}
+
+// EditMessageLiveLocation makes an EditMessageLiveLocationRequest. If the
+// edited message was sent by the bot, the edited Message is returned,
+// otherwise the returned Message is nil.
+func (b Bot) EditMessageLiveLocation(ctx context.Context, req EditMessageLiveLocationRequest) (*Message, error) {
+	return callEdit(ctx, b, req)
+}
+
+// StopMessageLiveLocation makes a StopMessageLiveLocationRequest. If the
+// edited message was sent by the bot, the edited Message is returned,
+// otherwise the returned Message is nil.
+func (b Bot) StopMessageLiveLocation(ctx context.Context, req StopMessageLiveLocationRequest) (*Message, error) {
+	return callEdit(ctx, b, req)
+}
+
+// SendMediaGroup makes a SendMediaGroupRequest and returns the sent Messages.
+func (b Bot) SendMediaGroup(ctx context.Context, req SendMediaGroupRequest) ([]Message, error) {
+	return Call[[]Message](ctx, b, req)
+}
+
+// SendPoll makes a SendPollRequest and returns the sent Message.
+func (b Bot) SendPoll(ctx context.Context, req SendPollRequest) (Message, error) {
+	return Call[Message](ctx, b, req)
+}
+
+// StopPoll makes a StopPollRequest and returns the stopped Poll.
+func (b Bot) StopPoll(ctx context.Context, req StopPollRequest) (Poll, error) {
+	return Call[Poll](ctx, b, req)
+}
+
+// SendContact makes a SendContactRequest and returns the sent Message.
+func (b Bot) SendContact(ctx context.Context, req SendContactRequest) (Message, error) {
+	return Call[Message](ctx, b, req)
+}
+
+// SendDice makes a SendDiceRequest and returns the sent Message.
+func (b Bot) SendDice(ctx context.Context, req SendDiceRequest) (Message, error) {
+	return Call[Message](ctx, b, req)
+}
+
+// SendChatAction makes a SendChatActionRequest. Returns True on success.
+func (b Bot) SendChatAction(ctx context.Context, req SendChatActionRequest) (bool, error) {
+	return Call[bool](ctx, b, req)
 }
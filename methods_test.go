@@ -0,0 +1,96 @@
+package ted
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCall(t *testing.T) {
+	client := &httpClient{
+		results: []result{
+			okResponse(`{"message_id":123,"chat":{"id":456,"type":"private"},"text":"Hello"}`),
+		},
+	}
+	bot := Bot{HTTPClient: client}
+	message, err := Call[Message](context.Background(), bot, SendMessageRequest{ChatID: 456, Text: "Hello"})
+	assert.NoError(t, err)
+	assert.Equal(t, 123, message.ID)
+	assert.Equal(t, int64(456), message.Chat.ID)
+	assert.Equal(t, "Hello", message.Text)
+}
+
+func TestBot_EditMessageText(t *testing.T) {
+	t.Run("returns edited message", func(t *testing.T) {
+		client := &httpClient{
+			results: []result{
+				okResponse(`{"message_id":123,"text":"New text"}`),
+			},
+		}
+		bot := Bot{HTTPClient: client}
+		message, err := bot.EditMessageText(context.Background(), EditMessageTextRequest{ChatID: 456, MessageID: 123, Text: "New text"})
+		assert.NoError(t, err)
+		assert.Equal(t, "New text", message.Text)
+	})
+	t.Run("returns nil for inline messages", func(t *testing.T) {
+		client := &httpClient{
+			results: []result{
+				okResponse(`true`),
+			},
+		}
+		bot := Bot{HTTPClient: client}
+		message, err := bot.EditMessageText(context.Background(), EditMessageTextRequest{InlineMessageID: "abc", Text: "New text"})
+		assert.NoError(t, err)
+		assert.Nil(t, message)
+	})
+}
+
+func TestBot_AnswerCallbackQuery(t *testing.T) {
+	client := &httpClient{
+		results: []result{
+			okResponse(`true`),
+		},
+	}
+	bot := Bot{HTTPClient: client}
+	ok, err := bot.AnswerCallbackQuery(context.Background(), AnswerCallbackQueryRequest{CallbackQueryID: "abc"})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestBot_CreateChatInviteLink(t *testing.T) {
+	client := &httpClient{
+		results: []result{
+			okResponse(`{"invite_link":"https://t.me/+abc","creator":{"id":1,"is_bot":true,"first_name":"Bot"},"creates_join_request":true,"is_primary":false,"is_revoked":false}`),
+		},
+	}
+	bot := Bot{HTTPClient: client}
+	link, err := bot.CreateChatInviteLink(context.Background(), CreateChatInviteLinkRequest{ChatID: -1001234, CreatesJoinRequest: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://t.me/+abc", link.InviteLink)
+	assert.True(t, link.CreatesJoinRequest)
+}
+
+func TestBot_CopyMessage(t *testing.T) {
+	client := &httpClient{
+		results: []result{
+			okResponse(`{"message_id":789}`),
+		},
+	}
+	bot := Bot{HTTPClient: client}
+	id, err := bot.CopyMessage(context.Background(), CopyMessageRequest{ChatID: 123, FromChatID: 456, MessageID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, 789, id.MessageID)
+}
+
+func TestBot_GetMe(t *testing.T) {
+	client := &httpClient{
+		results: []result{
+			okResponse(`{"id":123,"is_bot":true,"first_name":"Ted","username":"ted_bot"}`),
+		},
+	}
+	bot := Bot{HTTPClient: client}
+	me, err := bot.GetMe()
+	assert.NoError(t, err)
+	assert.Equal(t, "ted_bot", me.Username)
+}
@@ -0,0 +1,137 @@
+package ted
+
+import (
+	"context"
+	"errors"
+	"reflect"
+)
+
+// followMigration handles a request to a group which failed because the group
+// was migrated to a supergroup by calling the bot's OnChatMigrated hook and,
+// if FollowMigrations is set, making the request again to the supergroup.
+//
+// Telegram does not say which chat in a request was migrated, so nothing is
+// done if it cannot be told apart from the other chats in the request.
+// Requests which upload files are not made again, since their files have
+// already been read.
+func (b Bot) followMigration(ctx context.Context, request Request, err *APIError) (Response, error) {
+	field, oldChatID, ok := migratedChat(request)
+	if !ok {
+		return Response{}, err
+	}
+	if b.OnChatMigrated != nil {
+		b.OnChatMigrated(oldChatID, err.MigrateToChatID)
+	}
+	if !b.FollowMigrations || uploadsFile(reflect.ValueOf(request)) {
+		return Response{}, err
+	}
+	migrated, ok := withChatID(request, field, err.MigrateToChatID)
+	if !ok {
+		return Response{}, err
+	}
+	return b.do(ctx, migrated)
+}
+
+// chatFields are the fields of requests which may refer to a migrated group.
+var chatFields = []string{"ChatID", "FromChatID"}
+
+// migratedChat returns the name and value of the field of request referring
+// to the group which was migrated, or false if there is not exactly one
+// field which can refer to a group which has not been migrated yet.
+func migratedChat(request Request) (string, int64, bool) {
+	v := reflect.ValueOf(request)
+	if v.Kind() != reflect.Struct {
+		return "", 0, false
+	}
+	var name string
+	var chatID int64
+	for _, f := range chatFields {
+		field := v.FieldByName(f)
+		if !field.IsValid() || !field.CanInterface() {
+			continue
+		}
+		id, ok := chatIDInt64(field.Interface())
+		if !ok || !isBasicGroup(id) {
+			continue
+		}
+		if name != "" {
+			return "", 0, false
+		}
+		name, chatID = f, id
+	}
+	return name, chatID, name != ""
+}
+
+// isBasicGroup reports whether chatID refers to a group rather than a
+// supergroup or channel, whose identifiers begin with -100.
+func isBasicGroup(chatID int64) bool {
+	return chatID < 0 && chatID > -1000000000000
+}
+
+// withChatID returns a copy of request with the field name set to chatID, or
+// false if request does not have a field called name which can hold chatID.
+func withChatID(request Request, name string, chatID int64) (Request, bool) {
+	v := reflect.ValueOf(request)
+	if v.Kind() != reflect.Struct {
+		return nil, false
+	}
+	copied := reflect.New(v.Type()).Elem()
+	copied.Set(v)
+	field := copied.FieldByName(name)
+	value := reflect.ValueOf(chatID)
+	if !field.IsValid() || !field.CanSet() || !value.Type().AssignableTo(field.Type()) {
+		return nil, false
+	}
+	field.Set(value)
+	return copied.Interface().(Request), true
+}
+
+var fileReaderType = reflect.TypeOf(FileReader{})
+
+// uploadsFile reports whether v contains a FileReader, including inside
+// InputMedia.
+func uploadsFile(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		return !v.IsNil() && uploadsFile(v.Elem())
+	case reflect.Struct:
+		if v.Type() == fileReaderType {
+			return true
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if uploadsFile(v.Field(i)) {
+				return true
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if uploadsFile(v.Index(i)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// chatIDInt64 returns chatID as an int64 if it is a numeric chat ID.
+func chatIDInt64(chatID interface{}) (int64, bool) {
+	switch id := chatID.(type) {
+	case int:
+		return int64(id), true
+	case int32:
+		return int64(id), true
+	case int64:
+		return id, true
+	}
+	return 0, false
+}
+
+// isMigration reports whether err was caused by making a request to a group
+// which has been migrated to a supergroup.
+func isMigration(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.MigrateToChatID != 0 {
+		return apiErr, true
+	}
+	return nil, false
+}
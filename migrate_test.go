@@ -0,0 +1,130 @@
+package ted
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func migratedResponse() result {
+	return result{
+		res: &http.Response{Body: ioutil.NopCloser(strings.NewReader(`{"ok":false,"error_code":400,"description":"Bad Request: group chat was upgraded to a supergroup chat","parameters":{"migrate_to_chat_id":-1001234}}`))},
+	}
+}
+
+func TestBot_DoContext_Migration(t *testing.T) {
+	t.Run("makes request again to supergroup", func(t *testing.T) {
+		client := &httpClient{
+			results: []result{
+				migratedResponse(),
+				okResponse(`{"message_id":1}`),
+			},
+		}
+		var oldChatID, newChatID int64
+		bot := Bot{
+			HTTPClient:       client,
+			FollowMigrations: true,
+			OnChatMigrated: func(old, new int64) {
+				oldChatID, newChatID = old, new
+			},
+		}
+		_, err := bot.Do(SendMessageRequest{ChatID: -1234, Text: "Hello"})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(-1234), oldChatID)
+		assert.Equal(t, int64(-1001234), newChatID)
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(client.requests[1].Body).Decode(&body))
+		assert.Equal(t, float64(-1001234), body["chat_id"])
+		assert.Equal(t, "Hello", body["text"])
+	})
+	t.Run("returns error when not following migrations", func(t *testing.T) {
+		client := &httpClient{
+			results: []result{
+				migratedResponse(),
+			},
+		}
+		called := false
+		bot := Bot{
+			HTTPClient: client,
+			OnChatMigrated: func(old, new int64) {
+				called = true
+			},
+		}
+		_, err := bot.Do(SendMessageRequest{ChatID: -1234, Text: "Hello"})
+		assert.True(t, errors.Is(err, ErrChatMigrated))
+		assert.True(t, called)
+		assert.Len(t, client.requests, 1)
+	})
+	t.Run("makes request again with the chat which was migrated", func(t *testing.T) {
+		client := &httpClient{
+			results: []result{
+				migratedResponse(),
+				okResponse(`{"message_id":1}`),
+			},
+		}
+		var oldChatID int64
+		bot := Bot{
+			HTTPClient:       client,
+			FollowMigrations: true,
+			OnChatMigrated: func(old, new int64) {
+				oldChatID = old
+			},
+		}
+		_, err := bot.Do(ForwardMessageRequest{ChatID: int64(-1001234567890), FromChatID: -1234, MessageID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(-1234), oldChatID)
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(client.requests[1].Body).Decode(&body))
+		assert.Equal(t, float64(-1001234567890), body["chat_id"])
+		assert.Equal(t, float64(-1001234), body["from_chat_id"])
+	})
+	t.Run("returns error when the migrated chat is ambiguous", func(t *testing.T) {
+		client := &httpClient{
+			results: []result{
+				migratedResponse(),
+			},
+		}
+		called := false
+		bot := Bot{
+			HTTPClient:       client,
+			FollowMigrations: true,
+			OnChatMigrated: func(old, new int64) {
+				called = true
+			},
+		}
+		_, err := bot.Do(CopyMessageRequest{ChatID: -5678, FromChatID: -1234, MessageID: 1})
+		assert.True(t, errors.Is(err, ErrChatMigrated))
+		assert.False(t, called)
+		assert.Len(t, client.requests, 1)
+	})
+	t.Run("returns error for requests uploading files", func(t *testing.T) {
+		client := &httpClient{
+			results: []result{
+				migratedResponse(),
+			},
+		}
+		var oldChatID int64
+		bot := Bot{
+			HTTPClient:       client,
+			FollowMigrations: true,
+			OnChatMigrated: func(old, new int64) {
+				oldChatID = old
+			},
+		}
+		_, err := bot.Do(SendMediaGroupRequest{
+			ChatID: -1234,
+			Media: []InputMedia{
+				InputMediaPhoto{Media: FileID("abc")},
+				InputMediaPhoto{Media: FileReader{Name: "photo.jpg", Reader: strings.NewReader("contents")}},
+			},
+		})
+		assert.True(t, errors.Is(err, ErrChatMigrated))
+		assert.Equal(t, int64(-1234), oldChatID)
+		assert.Len(t, client.requests, 1)
+	})
+}
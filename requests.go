@@ -1,19 +1,22 @@
 package ted
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"time"
 )
 
 type GetMeRequest struct{}
 
-func (g GetMeRequest) doWith(bot Bot) (Response, error) {
-	return bot.doQuery("getMe", nil)
+func (g GetMeRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doQuery(ctx, "getMe", nil)
 }
 
 type GetWebhookInfoRequest struct{}
 
-func (g GetWebhookInfoRequest) doWith(bot Bot) (Response, error) {
-	return bot.doQuery("getWebhookInfo", nil)
+func (g GetWebhookInfoRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doQuery(ctx, "getWebhookInfo", nil)
 }
 
 type SetWebhookRequest struct {
@@ -38,10 +41,16 @@ type SetWebhookRequest struct {
 	// before the call to the setWebhook, so unwanted updates may be
 	// received for a short period of time.¬
 	AllowedUpdates []string
+
+	// SecretToken will be sent in the X-Telegram-Bot-Api-Secret-Token
+	// header of every webhook request, 1-256 characters. Only characters
+	// A-Z, a-z, 0-9, _ and - are allowed. The header is useful to ensure
+	// that the request comes from a webhook set by you.
+	SecretToken string
 }
 
-func (s SetWebhookRequest) doWith(bot Bot) (Response, error) {
-	return bot.doJSON("setWebhook", s)
+func (s SetWebhookRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "setWebhook", s)
 }
 
 func (s SetWebhookRequest) MarshalJSON() ([]byte, error) {
@@ -54,6 +63,9 @@ func (s SetWebhookRequest) MarshalJSON() ([]byte, error) {
 	if s.AllowedUpdates != nil {
 		data["allowed_updates"] = s.AllowedUpdates
 	}
+	if s.SecretToken != "" {
+		data["secret_token"] = s.SecretToken
+	}
 	return json.Marshal(data)
 }
 
@@ -110,8 +122,12 @@ func (r SendMessageRequest) MarshalJSON() ([]byte, error) {
 	return json.Marshal(req)
 }
 
-func (r SendMessageRequest) doWith(bot Bot) (Response, error) {
-	return bot.doJSON("sendMessage", r)
+func (r SendMessageRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "sendMessage", r)
+}
+
+func (r SendMessageRequest) messageChatID() interface{} {
+	return r.ChatID
 }
 
 type ReplyMarkup interface {
@@ -263,8 +279,8 @@ type AnswerCallbackQueryRequest struct {
 	CacheTime int `json:"cache_time,omitempty"`
 }
 
-func (r AnswerCallbackQueryRequest) doWith(bot Bot) (Response, error) {
-	return bot.doJSON("answerCallbackQuery", r)
+func (r AnswerCallbackQueryRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "answerCallbackQuery", r)
 }
 
 type EditMessageTextRequest struct {
@@ -295,17 +311,13 @@ type EditMessageTextRequest struct {
 
 func (e EditMessageTextRequest) MarshalJSON() ([]byte, error) {
 	req := struct {
-		ChatID                interface{} `json:"chat_id,omitempty"`
-		MessageID             int         `json:"message_id,omitempty"`
-		InlineMessageID       string      `json:"inline_message_id,omitempty"`
-		Text                  string      `json:"text"`
-		ParseMode             string      `json:"parse_mode,omitempty"`
-		DisableWebPagePreview bool        `json:"disable_web_page_preview,omitempty"`
-		ReplyMarkup           string      `json:"reply_markup,omitempty"`
+		messageTarget
+		Text                  string `json:"text"`
+		ParseMode             string `json:"parse_mode,omitempty"`
+		DisableWebPagePreview bool   `json:"disable_web_page_preview,omitempty"`
+		ReplyMarkup           string `json:"reply_markup,omitempty"`
 	}{
-		ChatID:                e.ChatID,
-		MessageID:             e.MessageID,
-		InlineMessageID:       e.InlineMessageID,
+		messageTarget:         e.target(),
 		Text:                  e.Text,
 		ParseMode:             e.ParseMode,
 		DisableWebPagePreview: e.DisableWebPagePreview,
@@ -320,8 +332,16 @@ func (e EditMessageTextRequest) MarshalJSON() ([]byte, error) {
 	return json.Marshal(req)
 }
 
-func (e EditMessageTextRequest) doWith(bot Bot) (Response, error) {
-	return bot.doJSON("editMessageText", e)
+func (e EditMessageTextRequest) target() messageTarget {
+	return messageTarget{e.ChatID, e.MessageID, e.InlineMessageID}
+}
+
+func (e EditMessageTextRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	err := e.target().validate()
+	if err != nil {
+		return Response{}, err
+	}
+	return bot.doJSON(ctx, "editMessageText", e)
 }
 
 // This object represents the content of a message to be sent as a result of an inline query. Telegram clients currently support the following 4 types:
@@ -379,6 +399,23 @@ type InputVenueMessageContent struct {
 
 func (i InputVenueMessageContent) inputMessageContent() {}
 
+// Represents the content of a contact message to be sent as the result of an inline query.
+type InputContactMessageContent struct {
+	// Contact's phone number
+	PhoneNumber string `json:"phone_number"`
+
+	// Contact's first name
+	FirstName string `json:"first_name"`
+
+	// Optional. Contact's last name
+	LastName string `json:"last_name,omitempty"`
+
+	// Optional. Additional data about the contact in the form of a vCard, 0-2048 bytes
+	VCard string `json:"vcard,omitempty"`
+}
+
+func (i InputContactMessageContent) inputMessageContent() {}
+
 // This object represents one result of an inline query. Telegram clients currently support results of the following 20 types:
 //
 //  InlineQueryResultCachedAudio
@@ -575,8 +612,8 @@ type AnswerInlineQueryRequest struct {
 	SwitchPMParameter string
 }
 
-func (r AnswerInlineQueryRequest) doWith(bot Bot) (Response, error) {
-	return bot.doJSON("answerInlineQuery", r)
+func (r AnswerInlineQueryRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "answerInlineQuery", r)
 }
 
 type InlineQueryResults []InlineQueryResult
@@ -627,14 +664,10 @@ type EditMessageReplyMarkupRequest struct {
 
 func (e EditMessageReplyMarkupRequest) MarshalJSON() ([]byte, error) {
 	req := struct {
-		ChatID          interface{} `json:"chat_id,omitempty"`
-		MessageID       int         `json:"message_id,omitempty"`
-		InlineMessageID string      `json:"inline_message_id,omitempty"`
-		ReplyMarkup     string      `json:"reply_markup,omitempty"`
+		messageTarget
+		ReplyMarkup string `json:"reply_markup,omitempty"`
 	}{
-		ChatID:          e.ChatID,
-		MessageID:       e.MessageID,
-		InlineMessageID: e.InlineMessageID,
+		messageTarget: e.target(),
 	}
 	if e.ReplyMarkup != nil {
 		markup, err := json.Marshal(e.ReplyMarkup)
@@ -646,8 +679,16 @@ func (e EditMessageReplyMarkupRequest) MarshalJSON() ([]byte, error) {
 	return json.Marshal(req)
 }
 
-func (e EditMessageReplyMarkupRequest) doWith(bot Bot) (Response, error) {
-	return bot.doJSON("editMessageReplyMarkup", e)
+func (e EditMessageReplyMarkupRequest) target() messageTarget {
+	return messageTarget{e.ChatID, e.MessageID, e.InlineMessageID}
+}
+
+func (e EditMessageReplyMarkupRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	err := e.target().validate()
+	if err != nil {
+		return Response{}, err
+	}
+	return bot.doJSON(ctx, "editMessageReplyMarkup", e)
 }
 
 // BotCommand represents a bot command.
@@ -664,27 +705,51 @@ type SetMyCommandsRequest struct {
 	Commands []BotCommand `json:"commands"`
 }
 
-func (s SetMyCommandsRequest) doWith(bot Bot) (Response, error) {
-	return bot.doJSON("setMyCommands", s)
+func (s SetMyCommandsRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "setMyCommands", s)
 }
 
 type GetMyCommandsRequest struct{}
 
-func (g GetMyCommandsRequest) doWith(bot Bot) (Response, error) {
-	return bot.doQuery("getMyCommands", nil)
+func (g GetMyCommandsRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doQuery(ctx, "getMyCommands", nil)
 }
 
+// SendLocationRequest sends a point on the map. On success, the sent Message
+// is returned.
 type SendLocationRequest struct {
-	ChatID              interface{} `json:"chat_id"`
-	Latitude            float32     `json:"latitude"`
-	Longitude           float32     `json:"longitude"`
+	ChatID    interface{} `json:"chat_id"`
+	Latitude  float32     `json:"latitude"`
+	Longitude float32     `json:"longitude"`
+
+	// Optional. The radius of uncertainty for the location, measured in
+	// meters; 0-1500
+	HorizontalAccuracy float32 `json:"horizontal_accuracy,omitempty"`
+
+	// Optional. Period in seconds for which the location will be updated,
+	// should be between 60 and 86400. See LiveLocation.
+	LivePeriod int `json:"live_period,omitempty"`
+
+	// Optional. For live locations, a direction in which the user is
+	// moving, in degrees. Must be between 1 and 360 if specified.
+	Heading int `json:"heading,omitempty"`
+
+	// Optional. For live locations, a maximum distance for proximity alerts
+	// about approaching another chat member, in meters. Must be between 1
+	// and 100000 if specified.
+	ProximityAlertRadius int `json:"proximity_alert_radius,omitempty"`
+
 	DisableNotification bool        `json:"disable_notification,omitempty"`
 	ReplyToMessageID    int         `json:"reply_to_message_id,omitempty"`
 	ReplyMarkup         ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
-func (r SendLocationRequest) doWith(bot Bot) (Response, error) {
-	return bot.doJSON("sendLocation", r)
+func (r SendLocationRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "sendLocation", r)
+}
+
+func (r SendLocationRequest) messageChatID() interface{} {
+	return r.ChatID
 }
 
 // SendVenueRequest sends information about a venue. On success, the sent Message is returned.
@@ -699,6 +764,1586 @@ type SendVenueRequest struct {
 	ReplyMarkup         ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
-func (r SendVenueRequest) doWith(bot Bot) (Response, error) {
-	return bot.doJSON("sendVenue", r)
+func (r SendVenueRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "sendVenue", r)
+}
+
+func (r SendVenueRequest) messageChatID() interface{} {
+	return r.ChatID
+}
+
+// GetUpdatesRequest receives incoming updates using long polling. An Array of
+// Update objects is returned.
+//
+// This method will not work if an outgoing webhook is set up.
+type GetUpdatesRequest struct {
+	// Identifier of the first update to be returned. Must be greater by one
+	// than the highest among the identifiers of previously received
+	// updates. By default, updates starting with the earliest unconfirmed
+	// update are returned. An update is considered confirmed as soon as
+	// getUpdates is called with an offset higher than its update_id.
+	Offset int
+
+	// Limits the number of updates to be retrieved. Values between 1-100
+	// are accepted. Defaults to 100.
+	Limit int
+
+	// Timeout in seconds for long polling. Defaults to 0, i.e. usual short
+	// polling. Should be positive, short polling should be used for testing
+	// purposes only.
+	Timeout int
+
+	// A list of the update types you want your bot to receive. See
+	// SetWebhookRequest.AllowedUpdates for details. Specify an empty list to
+	// receive all updates regardless of type. If not specified, the previous
+	// setting will be used.
+	AllowedUpdates []string
+}
+
+func (r GetUpdatesRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "getUpdates", r)
+}
+
+func (r GetUpdatesRequest) MarshalJSON() ([]byte, error) {
+	data := map[string]interface{}{}
+	if r.Offset != 0 {
+		data["offset"] = r.Offset
+	}
+	if r.Limit > 0 {
+		data["limit"] = r.Limit
+	}
+	if r.Timeout > 0 {
+		data["timeout"] = r.Timeout
+	}
+	if r.AllowedUpdates != nil {
+		data["allowed_updates"] = r.AllowedUpdates
+	}
+	return json.Marshal(data)
+}
+
+// SendPhotoRequest sends a photo. On success, the sent Message is returned.
+type SendPhotoRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{}
+
+	// Photo to send. The photo must be at most 10 MB in size. The photo's
+	// width and height must not exceed 10000 in total. Width and height
+	// ratio must be at most 20.
+	Photo InputFile
+
+	// Optional. Photo caption (may also be used when resending photos by
+	// file_id), 0-1024 characters after entities parsing
+	Caption string
+
+	// Optional. Mode for parsing entities in the photo caption.
+	ParseMode string
+
+	// Optional. Sends the message silently. Users will receive a
+	// notification with no sound.
+	DisableNotification bool
+
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageID int
+
+	// Optional. Additional interface options.
+	ReplyMarkup ReplyMarkup
+}
+
+func (r SendPhotoRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doUpload(ctx, "sendPhoto", map[string]interface{}{
+		"chat_id":              r.ChatID,
+		"photo":                r.Photo,
+		"caption":              r.Caption,
+		"parse_mode":           r.ParseMode,
+		"disable_notification": r.DisableNotification,
+		"reply_to_message_id":  r.ReplyToMessageID,
+		"reply_markup":         r.ReplyMarkup,
+	})
+}
+
+func (r SendPhotoRequest) messageChatID() interface{} {
+	return r.ChatID
+}
+
+// SendAudioRequest sends an audio file to be displayed in the music player.
+// The audio must be in the .MP3 or .M4A format. On success, the sent Message
+// is returned. Bots can currently send audio files of up to 50 MB in size.
+//
+// For sending voice messages, use SendVoiceRequest instead.
+type SendAudioRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{}
+
+	// Audio file to send
+	Audio InputFile
+
+	// Optional. Audio caption, 0-1024 characters after entities parsing
+	Caption string
+
+	// Optional. Mode for parsing entities in the audio caption.
+	ParseMode string
+
+	// Optional. Duration of the audio in seconds
+	Duration int
+
+	// Optional. Performer
+	Performer string
+
+	// Optional. Track name
+	Title string
+
+	// Optional. Thumbnail of the file sent. The thumbnail should be in JPEG
+	// format and less than 200 kB in size. A thumbnail's width and height
+	// should not exceed 320. Thumbnails can only be uploaded as a new file.
+	Thumb InputFile
+
+	// Optional. Sends the message silently. Users will receive a
+	// notification with no sound.
+	DisableNotification bool
+
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageID int
+
+	// Optional. Additional interface options.
+	ReplyMarkup ReplyMarkup
+}
+
+func (r SendAudioRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doUpload(ctx, "sendAudio", map[string]interface{}{
+		"chat_id":              r.ChatID,
+		"audio":                r.Audio,
+		"caption":              r.Caption,
+		"parse_mode":           r.ParseMode,
+		"duration":             r.Duration,
+		"performer":            r.Performer,
+		"title":                r.Title,
+		"thumb":                r.Thumb,
+		"disable_notification": r.DisableNotification,
+		"reply_to_message_id":  r.ReplyToMessageID,
+		"reply_markup":         r.ReplyMarkup,
+	})
+}
+
+func (r SendAudioRequest) messageChatID() interface{} {
+	return r.ChatID
+}
+
+// SendDocumentRequest sends a general file. On success, the sent Message is
+// returned. Bots can currently send files of any type of up to 50 MB in
+// size.
+type SendDocumentRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{}
+
+	// File to send
+	Document InputFile
+
+	// Optional. Thumbnail of the file sent. The thumbnail should be in JPEG
+	// format and less than 200 kB in size. A thumbnail's width and height
+	// should not exceed 320. Thumbnails can only be uploaded as a new file.
+	Thumb InputFile
+
+	// Optional. Document caption (may also be used when resending
+	// documents by file_id), 0-1024 characters after entities parsing
+	Caption string
+
+	// Optional. Mode for parsing entities in the document caption.
+	ParseMode string
+
+	// Optional. Sends the message silently. Users will receive a
+	// notification with no sound.
+	DisableNotification bool
+
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageID int
+
+	// Optional. Additional interface options.
+	ReplyMarkup ReplyMarkup
+}
+
+func (r SendDocumentRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doUpload(ctx, "sendDocument", map[string]interface{}{
+		"chat_id":              r.ChatID,
+		"document":             r.Document,
+		"thumb":                r.Thumb,
+		"caption":              r.Caption,
+		"parse_mode":           r.ParseMode,
+		"disable_notification": r.DisableNotification,
+		"reply_to_message_id":  r.ReplyToMessageID,
+		"reply_markup":         r.ReplyMarkup,
+	})
+}
+
+func (r SendDocumentRequest) messageChatID() interface{} {
+	return r.ChatID
+}
+
+// SendVideoRequest sends a video file. Telegram clients support mp4 videos
+// (other formats may be sent as a document). On success, the sent Message is
+// returned. Bots can currently send video files of up to 50 MB in size.
+type SendVideoRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{}
+
+	// Video to send
+	Video InputFile
+
+	// Optional. Duration of sent video in seconds
+	Duration int
+
+	// Optional. Video width
+	Width int
+
+	// Optional. Video height
+	Height int
+
+	// Optional. Thumbnail of the file sent. The thumbnail should be in JPEG
+	// format and less than 200 kB in size. A thumbnail's width and height
+	// should not exceed 320. Thumbnails can only be uploaded as a new file.
+	Thumb InputFile
+
+	// Optional. Video caption (may also be used when resending videos by
+	// file_id), 0-1024 characters after entities parsing
+	Caption string
+
+	// Optional. Mode for parsing entities in the video caption.
+	ParseMode string
+
+	// Optional. Pass True, if the uploaded video is suitable for streaming
+	SupportsStreaming bool
+
+	// Optional. Sends the message silently. Users will receive a
+	// notification with no sound.
+	DisableNotification bool
+
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageID int
+
+	// Optional. Additional interface options.
+	ReplyMarkup ReplyMarkup
+}
+
+func (r SendVideoRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doUpload(ctx, "sendVideo", map[string]interface{}{
+		"chat_id":              r.ChatID,
+		"video":                r.Video,
+		"duration":             r.Duration,
+		"width":                r.Width,
+		"height":               r.Height,
+		"thumb":                r.Thumb,
+		"caption":              r.Caption,
+		"parse_mode":           r.ParseMode,
+		"supports_streaming":   r.SupportsStreaming,
+		"disable_notification": r.DisableNotification,
+		"reply_to_message_id":  r.ReplyToMessageID,
+		"reply_markup":         r.ReplyMarkup,
+	})
+}
+
+func (r SendVideoRequest) messageChatID() interface{} {
+	return r.ChatID
+}
+
+// SendAnimationRequest sends an animation file (GIF or H.264/MPEG-4 AVC video
+// without sound). On success, the sent Message is returned. Bots can
+// currently send animation files of up to 50 MB in size.
+type SendAnimationRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{}
+
+	// Animation to send
+	Animation InputFile
+
+	// Optional. Duration of sent animation in seconds
+	Duration int
+
+	// Optional. Animation width
+	Width int
+
+	// Optional. Animation height
+	Height int
+
+	// Optional. Thumbnail of the file sent. The thumbnail should be in JPEG
+	// format and less than 200 kB in size. A thumbnail's width and height
+	// should not exceed 320. Thumbnails can only be uploaded as a new file.
+	Thumb InputFile
+
+	// Optional. Animation caption (may also be used when resending
+	// animation by file_id), 0-1024 characters after entities parsing
+	Caption string
+
+	// Optional. Mode for parsing entities in the animation caption.
+	ParseMode string
+
+	// Optional. Sends the message silently. Users will receive a
+	// notification with no sound.
+	DisableNotification bool
+
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageID int
+
+	// Optional. Additional interface options.
+	ReplyMarkup ReplyMarkup
+}
+
+func (r SendAnimationRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doUpload(ctx, "sendAnimation", map[string]interface{}{
+		"chat_id":              r.ChatID,
+		"animation":            r.Animation,
+		"duration":             r.Duration,
+		"width":                r.Width,
+		"height":               r.Height,
+		"thumb":                r.Thumb,
+		"caption":              r.Caption,
+		"parse_mode":           r.ParseMode,
+		"disable_notification": r.DisableNotification,
+		"reply_to_message_id":  r.ReplyToMessageID,
+		"reply_markup":         r.ReplyMarkup,
+	})
+}
+
+func (r SendAnimationRequest) messageChatID() interface{} {
+	return r.ChatID
+}
+
+// SendVoiceRequest sends an audio file to be displayed as a playable voice
+// message. For this to work, the audio must be in an .OGG file encoded with
+// OPUS (other formats may be sent as audio or document). On success, the
+// sent Message is returned. Bots can currently send voice messages of up to
+// 50 MB in size.
+type SendVoiceRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{}
+
+	// Audio file to send
+	Voice InputFile
+
+	// Optional. Voice message caption, 0-1024 characters after entities
+	// parsing
+	Caption string
+
+	// Optional. Mode for parsing entities in the voice message caption.
+	ParseMode string
+
+	// Optional. Duration of the voice message in seconds
+	Duration int
+
+	// Optional. Sends the message silently. Users will receive a
+	// notification with no sound.
+	DisableNotification bool
+
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageID int
+
+	// Optional. Additional interface options.
+	ReplyMarkup ReplyMarkup
+}
+
+func (r SendVoiceRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doUpload(ctx, "sendVoice", map[string]interface{}{
+		"chat_id":              r.ChatID,
+		"voice":                r.Voice,
+		"caption":              r.Caption,
+		"parse_mode":           r.ParseMode,
+		"duration":             r.Duration,
+		"disable_notification": r.DisableNotification,
+		"reply_to_message_id":  r.ReplyToMessageID,
+		"reply_markup":         r.ReplyMarkup,
+	})
+}
+
+func (r SendVoiceRequest) messageChatID() interface{} {
+	return r.ChatID
+}
+
+// GetFileRequest gets basic info about a file and prepares it for
+// downloading. For the moment, bots can download files of up to 20MB in
+// size. On success, a File object is returned.
+type GetFileRequest struct {
+	// File identifier to get info about
+	FileID string `json:"file_id"`
+}
+
+func (r GetFileRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "getFile", r)
+}
+
+// LogOutRequest logs out from the cloud Bot API server before launching the
+// bot locally. You must log out the bot before running it locally, otherwise
+// there is no guarantee that the bot will receive updates. After a
+// successful call, you can immediately log in on a local server, but will
+// not be able to log in back to the cloud Bot API server for 10 minutes.
+// Returns True on success.
+type LogOutRequest struct{}
+
+func (r LogOutRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doQuery(ctx, "logOut", nil)
+}
+
+// CloseRequest closes the bot instance before moving it from one local server
+// to another. You need to delete the webhook before calling this method to
+// ensure that the bot isn't launched again after server restart. The method
+// will return error 429 in the first 10 minutes after the bot is launched.
+// Returns True on success.
+type CloseRequest struct{}
+
+func (r CloseRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doQuery(ctx, "close", nil)
+}
+
+// GetChatRequest gets up to date information about the chat (current name of
+// the user for one-on-one conversations, current username of a user, group
+// or channel, etc.). Returns a Chat object on success.
+type GetChatRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// supergroup or channel (in the format @channelusername)
+	ChatID interface{} `json:"chat_id"`
+}
+
+func (r GetChatRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "getChat", r)
+}
+
+// GetChatAdministratorsRequest gets a list of administrators in a chat. On
+// success, returns an Array of ChatMember objects that contains information
+// about all chat administrators except other bots. If the chat is a group or
+// a supergroup and no administrators were appointed, only the creator will
+// be returned.
+type GetChatAdministratorsRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// supergroup or channel (in the format @channelusername)
+	ChatID interface{} `json:"chat_id"`
+}
+
+func (r GetChatAdministratorsRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "getChatAdministrators", r)
+}
+
+// GetChatMemberCountRequest gets the number of members in a chat. Returns Int
+// on success.
+type GetChatMemberCountRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// supergroup or channel (in the format @channelusername)
+	ChatID interface{} `json:"chat_id"`
+}
+
+func (r GetChatMemberCountRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "getChatMemberCount", r)
+}
+
+// GetChatMemberRequest gets information about a member of a chat. Returns a
+// ChatMember object on success.
+type GetChatMemberRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// supergroup or channel (in the format @channelusername)
+	ChatID interface{} `json:"chat_id"`
+
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id"`
+}
+
+func (r GetChatMemberRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "getChatMember", r)
+}
+
+// unixTime returns t as a Unix time, or 0 if t is the zero time.
+func unixTime(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+// BanChatMemberRequest bans a user in a group, a supergroup or a channel. In
+// the case of supergroups and channels, the user will not be able to return
+// to the chat on their own using invite links, etc., unless unbanned first.
+// The bot must be an administrator in the chat for this to work and must
+// have the appropriate administrator rights. Returns True on success.
+type BanChatMemberRequest struct {
+	// Unique identifier for the target group or username of the target
+	// supergroup or channel (in the format @channelusername)
+	ChatID interface{}
+
+	// Unique identifier of the target user
+	UserID int64
+
+	// Optional. Date when the user will be unbanned. If user is banned for
+	// more than 366 days or less than 30 seconds from the current time they
+	// are considered to be banned forever. Applied for supergroups and
+	// channels only.
+	UntilDate time.Time
+
+	// Optional. Pass True to delete all messages from the chat for the user
+	// that is being removed. If False, the user will be able to see
+	// messages in the group that were sent before the user was removed.
+	// Always True for supergroups and channels.
+	RevokeMessages bool
+}
+
+func (r BanChatMemberRequest) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		ChatID         interface{} `json:"chat_id"`
+		UserID         int64       `json:"user_id"`
+		UntilDate      int64       `json:"until_date,omitempty"`
+		RevokeMessages bool        `json:"revoke_messages,omitempty"`
+	}{
+		ChatID:         r.ChatID,
+		UserID:         r.UserID,
+		UntilDate:      unixTime(r.UntilDate),
+		RevokeMessages: r.RevokeMessages,
+	})
+}
+
+func (r BanChatMemberRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "banChatMember", r)
+}
+
+// UnbanChatMemberRequest unbans a previously banned user in a supergroup or
+// channel. The user will not return to the group or channel automatically,
+// but will be able to join via link, etc. The bot must be an administrator
+// for this to work. By default, this method guarantees that after the call
+// the user is not a member of the chat, but will be able to join it. So if
+// the user is a member of the chat they will also be removed from the chat.
+// If you don't want this, use the parameter OnlyIfBanned. Returns True on
+// success.
+type UnbanChatMemberRequest struct {
+	// Unique identifier for the target group or username of the target
+	// supergroup or channel (in the format @channelusername)
+	ChatID interface{} `json:"chat_id"`
+
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id"`
+
+	// Optional. Do nothing if the user is not banned
+	OnlyIfBanned bool `json:"only_if_banned,omitempty"`
+}
+
+func (r UnbanChatMemberRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "unbanChatMember", r)
+}
+
+// RestrictChatMemberRequest restricts a user in a supergroup. The bot must be
+// an administrator in the supergroup for this to work and must have the
+// appropriate administrator rights. Pass True for all permissions to lift
+// restrictions from a user. Returns True on success.
+type RestrictChatMemberRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// supergroup (in the format @supergroupusername)
+	ChatID interface{}
+
+	// Unique identifier of the target user
+	UserID int64
+
+	// New user permissions
+	Permissions ChatPermissions
+
+	// Optional. Date when restrictions will be lifted for the user. If user
+	// is restricted for more than 366 days or less than 30 seconds from the
+	// current time, they are considered to be restricted forever.
+	UntilDate time.Time
+}
+
+func (r RestrictChatMemberRequest) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		ChatID      interface{}     `json:"chat_id"`
+		UserID      int64           `json:"user_id"`
+		Permissions ChatPermissions `json:"permissions"`
+		UntilDate   int64           `json:"until_date,omitempty"`
+	}{
+		ChatID:      r.ChatID,
+		UserID:      r.UserID,
+		Permissions: r.Permissions,
+		UntilDate:   unixTime(r.UntilDate),
+	})
+}
+
+func (r RestrictChatMemberRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "restrictChatMember", r)
+}
+
+// PromoteChatMemberRequest promotes or demotes a user in a supergroup or a
+// channel. The bot must be an administrator in the chat for this to work and
+// must have the appropriate administrator rights. Pass False for all boolean
+// parameters to demote a user. Returns True on success.
+type PromoteChatMemberRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{} `json:"chat_id"`
+
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id"`
+
+	// Pass True, if the administrator's presence in the chat is hidden
+	IsAnonymous bool `json:"is_anonymous,omitempty"`
+
+	// Pass True, if the administrator can access the chat event log, chat
+	// statistics, message statistics in channels, see channel members, see
+	// anonymous administrators in supergroups and ignore slow mode. Implied
+	// by any other administrator privilege
+	CanManageChat bool `json:"can_manage_chat,omitempty"`
+
+	// Pass True, if the administrator can create channel posts, channels
+	// only
+	CanPostMessages bool `json:"can_post_messages,omitempty"`
+
+	// Pass True, if the administrator can edit messages of other users and
+	// can pin messages, channels only
+	CanEditMessages bool `json:"can_edit_messages,omitempty"`
+
+	// Pass True, if the administrator can delete messages of other users
+	CanDeleteMessages bool `json:"can_delete_messages,omitempty"`
+
+	// Pass True, if the administrator can manage voice chats
+	CanManageVoiceChats bool `json:"can_manage_voice_chats,omitempty"`
+
+	// Pass True, if the administrator can restrict, ban or unban chat
+	// members
+	CanRestrictMembers bool `json:"can_restrict_members,omitempty"`
+
+	// Pass True, if the administrator can add new administrators with a
+	// subset of their own privileges or demote administrators that he has
+	// promoted, directly or indirectly (promoted by administrators that were
+	// appointed by him)
+	CanPromoteMembers bool `json:"can_promote_members,omitempty"`
+
+	// Pass True, if the administrator can change chat title, photo and
+	// other settings
+	CanChangeInfo bool `json:"can_change_info,omitempty"`
+
+	// Pass True, if the administrator can invite new users to the chat
+	CanInviteUsers bool `json:"can_invite_users,omitempty"`
+
+	// Pass True, if the administrator can pin messages, supergroups only
+	CanPinMessages bool `json:"can_pin_messages,omitempty"`
+}
+
+func (r PromoteChatMemberRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "promoteChatMember", r)
+}
+
+// SetChatAdministratorCustomTitleRequest sets a custom title for an
+// administrator in a supergroup promoted by the bot. Returns True on success.
+type SetChatAdministratorCustomTitleRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// supergroup (in the format @supergroupusername)
+	ChatID interface{} `json:"chat_id"`
+
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id"`
+
+	// New custom title for the administrator; 0-16 characters, emoji are
+	// not allowed
+	CustomTitle string `json:"custom_title"`
+}
+
+func (r SetChatAdministratorCustomTitleRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "setChatAdministratorCustomTitle", r)
+}
+
+// SetChatPermissionsRequest sets default chat permissions for all members.
+// The bot must be an administrator in the group or a supergroup for this to
+// work and must have the can_restrict_members administrator rights. Returns
+// True on success.
+type SetChatPermissionsRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// supergroup (in the format @supergroupusername)
+	ChatID interface{} `json:"chat_id"`
+
+	// New default chat permissions
+	Permissions ChatPermissions `json:"permissions"`
+}
+
+func (r SetChatPermissionsRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "setChatPermissions", r)
+}
+
+// SetChatTitleRequest changes the title of a chat. Titles can't be changed
+// for private chats. The bot must be an administrator in the chat for this to
+// work and must have the appropriate administrator rights. Returns True on
+// success.
+type SetChatTitleRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{} `json:"chat_id"`
+
+	// New chat title, 1-255 characters
+	Title string `json:"title"`
+}
+
+func (r SetChatTitleRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "setChatTitle", r)
+}
+
+// SetChatDescriptionRequest changes the description of a group, a supergroup
+// or a channel. The bot must be an administrator in the chat for this to work
+// and must have the appropriate administrator rights. Returns True on
+// success.
+type SetChatDescriptionRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{} `json:"chat_id"`
+
+	// Optional. New chat description, 0-255 characters
+	Description string `json:"description,omitempty"`
+}
+
+func (r SetChatDescriptionRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "setChatDescription", r)
+}
+
+// SetChatPhotoRequest sets a new profile photo for the chat. Photos can't be
+// changed for private chats. The bot must be an administrator in the chat for
+// this to work and must have the appropriate administrator rights. Returns
+// True on success.
+type SetChatPhotoRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{}
+
+	// New chat photo. Chat photos can only be uploaded as new files.
+	Photo FileReader
+}
+
+func (r SetChatPhotoRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doUpload(ctx, "setChatPhoto", map[string]interface{}{
+		"chat_id": r.ChatID,
+		"photo":   r.Photo,
+	})
+}
+
+// DeleteChatPhotoRequest deletes a chat photo. Photos can't be changed for
+// private chats. The bot must be an administrator in the chat for this to
+// work and must have the appropriate administrator rights. Returns True on
+// success.
+type DeleteChatPhotoRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{} `json:"chat_id"`
+}
+
+func (r DeleteChatPhotoRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "deleteChatPhoto", r)
+}
+
+// PinChatMessageRequest adds a message to the list of pinned messages in a
+// chat. If the chat is not a private chat, the bot must be an administrator
+// in the chat for this to work and must have the 'can_pin_messages'
+// administrator right in a supergroup or 'can_edit_messages' administrator
+// right in a channel. Returns True on success.
+type PinChatMessageRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{} `json:"chat_id"`
+
+	// Identifier of a message to pin
+	MessageID int `json:"message_id"`
+
+	// Optional. Pass True, if it is not necessary to send a notification to
+	// all chat members about the new pinned message. Notifications are
+	// always disabled in channels and private chats.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+}
+
+func (r PinChatMessageRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "pinChatMessage", r)
+}
+
+// UnpinChatMessageRequest removes a message from the list of pinned messages
+// in a chat. If the chat is not a private chat, the bot must be an
+// administrator in the chat for this to work and must have the
+// 'can_pin_messages' administrator right in a supergroup or
+// 'can_edit_messages' administrator right in a channel. Returns True on
+// success.
+type UnpinChatMessageRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{} `json:"chat_id"`
+
+	// Optional. Identifier of a message to unpin. If not specified, the most
+	// recent pinned message (by sending date) will be unpinned.
+	MessageID int `json:"message_id,omitempty"`
+}
+
+func (r UnpinChatMessageRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "unpinChatMessage", r)
+}
+
+// UnpinAllChatMessagesRequest clears the list of pinned messages in a chat.
+// If the chat is not a private chat, the bot must be an administrator in the
+// chat for this to work and must have the 'can_pin_messages' administrator
+// right in a supergroup or 'can_edit_messages' administrator right in a
+// channel. Returns True on success.
+type UnpinAllChatMessagesRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{} `json:"chat_id"`
+}
+
+func (r UnpinAllChatMessagesRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "unpinAllChatMessages", r)
+}
+
+// LeaveChatRequest makes the bot leave a group, supergroup or channel.
+// Returns True on success.
+type LeaveChatRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// supergroup or channel (in the format @channelusername)
+	ChatID interface{} `json:"chat_id"`
+}
+
+func (r LeaveChatRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "leaveChat", r)
+}
+
+// ExportChatInviteLinkRequest generates a new primary invite link for a chat;
+// any previously generated primary link is revoked. The bot must be an
+// administrator in the chat for this to work and must have the appropriate
+// administrator rights. Returns the new invite link as String on success.
+type ExportChatInviteLinkRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{} `json:"chat_id"`
+}
+
+func (r ExportChatInviteLinkRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "exportChatInviteLink", r)
+}
+
+// CreateChatInviteLinkRequest creates an additional invite link for a chat.
+// The bot must be an administrator in the chat for this to work and must have
+// the appropriate administrator rights. The link can be revoked using
+// RevokeChatInviteLinkRequest. Returns the new invite link as ChatInviteLink
+// object.
+type CreateChatInviteLinkRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{}
+
+	// Optional. Invite link name; 0-32 characters
+	Name string
+
+	// Optional. Point in time when the link will expire
+	ExpireDate time.Time
+
+	// Optional. Maximum number of users that can be members of the chat
+	// simultaneously after joining the chat via this invite link; 1-99999
+	MemberLimit int
+
+	// Optional. True, if users joining the chat via the link need to be
+	// approved by chat administrators. If True, MemberLimit can't be
+	// specified
+	CreatesJoinRequest bool
+}
+
+func (r CreateChatInviteLinkRequest) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		ChatID             interface{} `json:"chat_id"`
+		Name               string      `json:"name,omitempty"`
+		ExpireDate         int64       `json:"expire_date,omitempty"`
+		MemberLimit        int         `json:"member_limit,omitempty"`
+		CreatesJoinRequest bool        `json:"creates_join_request,omitempty"`
+	}{
+		ChatID:             r.ChatID,
+		Name:               r.Name,
+		ExpireDate:         unixTime(r.ExpireDate),
+		MemberLimit:        r.MemberLimit,
+		CreatesJoinRequest: r.CreatesJoinRequest,
+	})
+}
+
+func (r CreateChatInviteLinkRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "createChatInviteLink", r)
+}
+
+// EditChatInviteLinkRequest edits a non-primary invite link created by the
+// bot. The bot must be an administrator in the chat for this to work and must
+// have the appropriate administrator rights. Returns the edited invite link
+// as a ChatInviteLink object.
+type EditChatInviteLinkRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{}
+
+	// The invite link to edit
+	InviteLink string
+
+	// Optional. Invite link name; 0-32 characters
+	Name string
+
+	// Optional. Point in time when the link will expire
+	ExpireDate time.Time
+
+	// Optional. Maximum number of users that can be members of the chat
+	// simultaneously after joining the chat via this invite link; 1-99999
+	MemberLimit int
+
+	// Optional. True, if users joining the chat via the link need to be
+	// approved by chat administrators. If True, MemberLimit can't be
+	// specified
+	CreatesJoinRequest bool
+}
+
+func (r EditChatInviteLinkRequest) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		ChatID             interface{} `json:"chat_id"`
+		InviteLink         string      `json:"invite_link"`
+		Name               string      `json:"name,omitempty"`
+		ExpireDate         int64       `json:"expire_date,omitempty"`
+		MemberLimit        int         `json:"member_limit,omitempty"`
+		CreatesJoinRequest bool        `json:"creates_join_request,omitempty"`
+	}{
+		ChatID:             r.ChatID,
+		InviteLink:         r.InviteLink,
+		Name:               r.Name,
+		ExpireDate:         unixTime(r.ExpireDate),
+		MemberLimit:        r.MemberLimit,
+		CreatesJoinRequest: r.CreatesJoinRequest,
+	})
+}
+
+func (r EditChatInviteLinkRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "editChatInviteLink", r)
+}
+
+// RevokeChatInviteLinkRequest revokes an invite link created by the bot. If
+// the primary link is revoked, a new link is automatically generated. The bot
+// must be an administrator in the chat for this to work and must have the
+// appropriate administrator rights. Returns the revoked invite link as
+// ChatInviteLink object.
+type RevokeChatInviteLinkRequest struct {
+	// Unique identifier of the target chat or username of the target channel
+	// (in the format @channelusername)
+	ChatID interface{} `json:"chat_id"`
+
+	// The invite link to revoke
+	InviteLink string `json:"invite_link"`
+}
+
+func (r RevokeChatInviteLinkRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "revokeChatInviteLink", r)
+}
+
+// ApproveChatJoinRequest approves a chat join request. The bot must be an
+// administrator in the chat for this to work and must have the
+// can_invite_users administrator right. Returns True on success.
+type ApproveChatJoinRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{} `json:"chat_id"`
+
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id"`
+}
+
+func (r ApproveChatJoinRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "approveChatJoinRequest", r)
+}
+
+// DeclineChatJoinRequest declines a chat join request. The bot must be an
+// administrator in the chat for this to work and must have the
+// can_invite_users administrator right. Returns True on success.
+type DeclineChatJoinRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{} `json:"chat_id"`
+
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id"`
+}
+
+func (r DeclineChatJoinRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "declineChatJoinRequest", r)
+}
+
+// ForwardMessageRequest forwards messages of any kind. Service messages can't
+// be forwarded. On success, the sent Message is returned.
+type ForwardMessageRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{} `json:"chat_id"`
+
+	// Unique identifier for the chat where the original message was sent
+	// (or channel username in the format @channelusername)
+	FromChatID interface{} `json:"from_chat_id"`
+
+	// Optional. Sends the message silently. Users will receive a
+	// notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Message identifier in the chat specified in FromChatID
+	MessageID int `json:"message_id"`
+}
+
+func (r ForwardMessageRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "forwardMessage", r)
+}
+
+func (r ForwardMessageRequest) messageChatID() interface{} {
+	return r.ChatID
+}
+
+// CopyMessageRequest copies messages of any kind. Service messages and
+// invoice messages can't be copied. The method is analogous to
+// ForwardMessageRequest, but the copied message doesn't have a link to the
+// original message. Returns the MessageID of the sent message on success.
+type CopyMessageRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{} `json:"chat_id"`
+
+	// Unique identifier for the chat where the original message was sent
+	// (or channel username in the format @channelusername)
+	FromChatID interface{} `json:"from_chat_id"`
+
+	// Message identifier in the chat specified in FromChatID
+	MessageID int `json:"message_id"`
+
+	// Optional. New caption for media, 0-1024 characters after entities
+	// parsing. If not specified, the original caption is kept
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the new caption.
+	ParseMode string `json:"parse_mode,omitempty"`
+
+	// Optional. Sends the message silently. Users will receive a
+	// notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageID int `json:"reply_to_message_id,omitempty"`
+
+	// Optional. Additional interface options.
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+func (r CopyMessageRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "copyMessage", r)
+}
+
+func (r CopyMessageRequest) messageChatID() interface{} {
+	return r.ChatID
+}
+
+// DeleteMessageRequest deletes a message, including service messages, with
+// the following limitations:
+//
+//   - A message can only be deleted if it was sent less than 48 hours ago.
+//   - Bots can delete outgoing messages in private chats, groups, and
+//     supergroups.
+//   - Bots can delete incoming messages in private chats.
+//   - If the bot is an administrator of a group, it can delete any message
+//     there.
+//   - If the bot has can_delete_messages permission in a supergroup or a
+//     channel, it can delete any message there.
+//
+// Returns True on success.
+type DeleteMessageRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{} `json:"chat_id"`
+
+	// Identifier of the message to delete
+	MessageID int `json:"message_id"`
+}
+
+func (r DeleteMessageRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "deleteMessage", r)
+}
+
+// EditMessageCaptionRequest edits captions of messages. On success, if the
+// edited message is not an inline message, the edited Message is returned,
+// otherwise True is returned.
+type EditMessageCaptionRequest struct {
+	// Required if InlineMessageID is not specified. Unique identifier for
+	// the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID interface{}
+
+	// Required if InlineMessageID is not specified. Identifier of the
+	// message to edit
+	MessageID int
+
+	// Required if ChatID and MessageID are not specified. Identifier of the
+	// inline message
+	InlineMessageID string
+
+	// Optional. New caption of the message, 0-1024 characters after entities
+	// parsing
+	Caption string
+
+	// Optional. Mode for parsing entities in the message caption.
+	ParseMode string
+
+	// Optional. A JSON-serialized object for an inline keyboard.
+	ReplyMarkup *InlineKeyboardMarkup
+}
+
+func (r EditMessageCaptionRequest) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		messageTarget
+		Caption     string                `json:"caption,omitempty"`
+		ParseMode   string                `json:"parse_mode,omitempty"`
+		ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	}{
+		messageTarget: r.target(),
+		Caption:       r.Caption,
+		ParseMode:     r.ParseMode,
+		ReplyMarkup:   r.ReplyMarkup,
+	})
+}
+
+func (r EditMessageCaptionRequest) target() messageTarget {
+	return messageTarget{r.ChatID, r.MessageID, r.InlineMessageID}
+}
+
+func (r EditMessageCaptionRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	err := r.target().validate()
+	if err != nil {
+		return Response{}, err
+	}
+	return bot.doJSON(ctx, "editMessageCaption", r)
+}
+
+// EditMessageMediaRequest edits animation, audio, document, photo, or video
+// messages. If a message is part of a message album, then it can be edited
+// only to an audio for audio albums, only to a document for document albums
+// and to a photo or a video otherwise. When an inline message is edited, a
+// new file can't be uploaded; use a previously uploaded file via its file_id
+// or specify a URL. On success, if the edited message is not an inline
+// message, the edited Message is returned, otherwise True is returned.
+type EditMessageMediaRequest struct {
+	// Required if InlineMessageID is not specified. Unique identifier for
+	// the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID interface{}
+
+	// Required if InlineMessageID is not specified. Identifier of the
+	// message to edit
+	MessageID int
+
+	// Required if ChatID and MessageID are not specified. Identifier of the
+	// inline message
+	InlineMessageID string
+
+	// New media content of the message
+	Media InputMedia
+
+	// Optional. A JSON-serialized object for a new inline keyboard.
+	ReplyMarkup *InlineKeyboardMarkup
+}
+
+func (r EditMessageMediaRequest) target() messageTarget {
+	return messageTarget{r.ChatID, r.MessageID, r.InlineMessageID}
+}
+
+func (r EditMessageMediaRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	err := r.target().validate()
+	if err != nil {
+		return Response{}, err
+	}
+	if r.Media == nil {
+		return Response{}, ErrNilMedia
+	}
+	files := make(attachments)
+	media, err := r.Media.inputMedia(files)
+	if err != nil {
+		return Response{}, err
+	}
+	params := r.target().params(map[string]interface{}{
+		"media":        media,
+		"reply_markup": r.ReplyMarkup,
+	})
+	files.addTo(params)
+	return bot.doUpload(ctx, "editMessageMedia", params)
+}
+
+// EditMessageLiveLocationRequest edits live location messages. A location can
+// be edited until its live period expires or editing is explicitly disabled
+// by a StopMessageLiveLocationRequest. On success, if the edited message is
+// not an inline message, the edited Message is returned, otherwise True is
+// returned.
+type EditMessageLiveLocationRequest struct {
+	// Required if InlineMessageID is not specified. Unique identifier for
+	// the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID interface{}
+
+	// Required if InlineMessageID is not specified. Identifier of the
+	// message to edit
+	MessageID int
+
+	// Required if ChatID and MessageID are not specified. Identifier of the
+	// inline message
+	InlineMessageID string
+
+	// Latitude of new location
+	Latitude float32
+
+	// Longitude of new location
+	Longitude float32
+
+	// Optional. The radius of uncertainty for the location, measured in
+	// meters; 0-1500
+	HorizontalAccuracy float32
+
+	// Optional. Direction in which the user is moving, in degrees. Must be
+	// between 1 and 360 if specified.
+	Heading int
+
+	// Optional. Maximum distance for proximity alerts about approaching
+	// another chat member, in meters. Must be between 1 and 100000 if
+	// specified.
+	ProximityAlertRadius int
+
+	// Optional. A JSON-serialized object for a new inline keyboard.
+	ReplyMarkup *InlineKeyboardMarkup
+}
+
+func (r EditMessageLiveLocationRequest) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		messageTarget
+		Latitude             float32               `json:"latitude"`
+		Longitude            float32               `json:"longitude"`
+		HorizontalAccuracy   float32               `json:"horizontal_accuracy,omitempty"`
+		Heading              int                   `json:"heading,omitempty"`
+		ProximityAlertRadius int                   `json:"proximity_alert_radius,omitempty"`
+		ReplyMarkup          *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	}{
+		messageTarget:        r.target(),
+		Latitude:             r.Latitude,
+		Longitude:            r.Longitude,
+		HorizontalAccuracy:   r.HorizontalAccuracy,
+		Heading:              r.Heading,
+		ProximityAlertRadius: r.ProximityAlertRadius,
+		ReplyMarkup:          r.ReplyMarkup,
+	})
+}
+
+func (r EditMessageLiveLocationRequest) target() messageTarget {
+	return messageTarget{r.ChatID, r.MessageID, r.InlineMessageID}
+}
+
+func (r EditMessageLiveLocationRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	err := r.target().validate()
+	if err != nil {
+		return Response{}, err
+	}
+	return bot.doJSON(ctx, "editMessageLiveLocation", r)
+}
+
+// StopMessageLiveLocationRequest stops updating a live location message
+// before its live period expires. On success, if the message is not an
+// inline message, the edited Message is returned, otherwise True is returned.
+type StopMessageLiveLocationRequest struct {
+	// Required if InlineMessageID is not specified. Unique identifier for
+	// the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID interface{}
+
+	// Required if InlineMessageID is not specified. Identifier of the
+	// message with live location to stop
+	MessageID int
+
+	// Required if ChatID and MessageID are not specified. Identifier of the
+	// inline message
+	InlineMessageID string
+
+	// Optional. A JSON-serialized object for a new inline keyboard.
+	ReplyMarkup *InlineKeyboardMarkup
+}
+
+func (r StopMessageLiveLocationRequest) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		messageTarget
+		ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	}{
+		messageTarget: r.target(),
+		ReplyMarkup:   r.ReplyMarkup,
+	})
+}
+
+func (r StopMessageLiveLocationRequest) target() messageTarget {
+	return messageTarget{r.ChatID, r.MessageID, r.InlineMessageID}
+}
+
+func (r StopMessageLiveLocationRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	err := r.target().validate()
+	if err != nil {
+		return Response{}, err
+	}
+	return bot.doJSON(ctx, "stopMessageLiveLocation", r)
+}
+
+// SendMediaGroupRequest sends a group of photos, videos, documents or audios
+// as an album. Documents and audio files can be only grouped in an album with
+// messages of the same type. On success, an array of Messages that were sent
+// is returned.
+type SendMediaGroupRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{}
+
+	// An array describing messages to be sent, must include 2-10 items.
+	// Items must be InputMediaAudio, InputMediaDocument, InputMediaPhoto or
+	// InputMediaVideo.
+	Media []InputMedia
+
+	// Optional. Sends messages silently. Users will receive a notification
+	// with no sound.
+	DisableNotification bool
+
+	// Optional. If the messages are a reply, ID of the original message
+	ReplyToMessageID int
+}
+
+func (r SendMediaGroupRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	files := make(attachments)
+	media := make([]interface{}, len(r.Media))
+	for i, m := range r.Media {
+		switch m.(type) {
+		case InputMediaAudio, InputMediaDocument, InputMediaPhoto, InputMediaVideo:
+		case nil:
+			return Response{}, fmt.Errorf("%w: item %d", ErrNilMedia, i)
+		default:
+			return Response{}, fmt.Errorf("%w: item %d is %T", ErrUnsupportedMedia, i, m)
+		}
+		item, err := m.inputMedia(files)
+		if err != nil {
+			return Response{}, fmt.Errorf("%w: item %d", err, i)
+		}
+		media[i] = item
+	}
+	params := map[string]interface{}{
+		"chat_id":              r.ChatID,
+		"media":                media,
+		"disable_notification": r.DisableNotification,
+		"reply_to_message_id":  r.ReplyToMessageID,
+	}
+	files.addTo(params)
+	return bot.doUpload(ctx, "sendMediaGroup", params)
+}
+
+func (r SendMediaGroupRequest) messageChatID() interface{} {
+	return r.ChatID
+}
+
+// SendPollRequest sends a native poll. On success, the sent Message is
+// returned.
+type SendPollRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{}
+
+	// Poll question, 1-300 characters
+	Question string
+
+	// List of answer options, 2-10 strings 1-100 characters each
+	Options []string
+
+	// Optional. True, if the poll needs to be anonymous, defaults to True.
+	// Answers to a poll are only received as PollAnswer updates if it is not
+	// anonymous.
+	IsAnonymous *bool
+
+	// Optional. Poll type, PollQuiz or PollRegular, defaults to PollRegular
+	Type string
+
+	// Optional. True, if the poll allows multiple answers, ignored for polls
+	// in quiz mode
+	AllowsMultipleAnswers bool
+
+	// 0-based identifier of the correct answer option, required for polls
+	// in quiz mode
+	CorrectOptionID int
+
+	// Optional. Text that is shown when a user chooses an incorrect answer
+	// or taps on the lamp icon in a quiz-style poll, 0-200 characters with
+	// at most 2 line feeds after entities parsing
+	Explanation string
+
+	// Optional. Mode for parsing entities in the explanation.
+	ExplanationParseMode string
+
+	// Optional. Amount of time in seconds the poll will be active after
+	// creation, 5-600. Can't be used together with CloseDate.
+	OpenPeriod int
+
+	// Optional. Point in time when the poll will be automatically closed.
+	// Must be at least 5 and no more than 600 seconds in the future. Can't be
+	// used together with OpenPeriod.
+	CloseDate time.Time
+
+	// Optional. Pass True, if the poll needs to be immediately closed. This
+	// can be useful for poll preview.
+	IsClosed bool
+
+	// Optional. Sends the message silently. Users will receive a
+	// notification with no sound.
+	DisableNotification bool
+
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageID int
+
+	// Optional. Additional interface options.
+	ReplyMarkup ReplyMarkup
+}
+
+func (r SendPollRequest) MarshalJSON() ([]byte, error) {
+	req := struct {
+		ChatID                interface{} `json:"chat_id"`
+		Question              string      `json:"question"`
+		Options               []string    `json:"options"`
+		IsAnonymous           *bool       `json:"is_anonymous,omitempty"`
+		Type                  string      `json:"type,omitempty"`
+		AllowsMultipleAnswers bool        `json:"allows_multiple_answers,omitempty"`
+		CorrectOptionID       *int        `json:"correct_option_id,omitempty"`
+		Explanation           string      `json:"explanation,omitempty"`
+		ExplanationParseMode  string      `json:"explanation_parse_mode,omitempty"`
+		OpenPeriod            int         `json:"open_period,omitempty"`
+		CloseDate             int64       `json:"close_date,omitempty"`
+		IsClosed              bool        `json:"is_closed,omitempty"`
+		DisableNotification   bool        `json:"disable_notification,omitempty"`
+		ReplyToMessageID      int         `json:"reply_to_message_id,omitempty"`
+		ReplyMarkup           ReplyMarkup `json:"reply_markup,omitempty"`
+	}{
+		ChatID:                r.ChatID,
+		Question:              r.Question,
+		Options:               r.Options,
+		IsAnonymous:           r.IsAnonymous,
+		Type:                  r.Type,
+		AllowsMultipleAnswers: r.AllowsMultipleAnswers,
+		Explanation:           r.Explanation,
+		ExplanationParseMode:  r.ExplanationParseMode,
+		OpenPeriod:            r.OpenPeriod,
+		CloseDate:             unixTime(r.CloseDate),
+		IsClosed:              r.IsClosed,
+		DisableNotification:   r.DisableNotification,
+		ReplyToMessageID:      r.ReplyToMessageID,
+		ReplyMarkup:           r.ReplyMarkup,
+	}
+	if r.Type == PollQuiz {
+		req.CorrectOptionID = &r.CorrectOptionID
+	}
+	return json.Marshal(req)
+}
+
+func (r SendPollRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "sendPoll", r)
+}
+
+func (r SendPollRequest) messageChatID() interface{} {
+	return r.ChatID
+}
+
+// StopPollRequest stops a poll which was sent by the bot. On success, the
+// stopped Poll is returned.
+type StopPollRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{} `json:"chat_id"`
+
+	// Identifier of the original message with the poll
+	MessageID int `json:"message_id"`
+
+	// Optional. A JSON-serialized object for a new message inline keyboard.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+func (r StopPollRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "stopPoll", r)
+}
+
+// SendContactRequest sends a phone contact. On success, the sent Message is
+// returned.
+type SendContactRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{} `json:"chat_id"`
+
+	// Contact's phone number
+	PhoneNumber string `json:"phone_number"`
+
+	// Contact's first name
+	FirstName string `json:"first_name"`
+
+	// Optional. Contact's last name
+	LastName string `json:"last_name,omitempty"`
+
+	// Optional. Additional data about the contact in the form of a vCard,
+	// 0-2048 bytes
+	VCard string `json:"vcard,omitempty"`
+
+	// Optional. Sends the message silently. Users will receive a
+	// notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageID int `json:"reply_to_message_id,omitempty"`
+
+	// Optional. Additional interface options.
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+func (r SendContactRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "sendContact", r)
+}
+
+func (r SendContactRequest) messageChatID() interface{} {
+	return r.ChatID
+}
+
+// SendDiceRequest sends an animated emoji that will display a random value.
+// On success, the sent Message is returned.
+type SendDiceRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{} `json:"chat_id"`
+
+	// Optional. Emoji on which the dice throw animation is based. Currently,
+	// must be one of “🎲”, “🎯”, “🏀”, “⚽”, or “🎰”. Defaults to “🎲”.
+	Emoji string `json:"emoji,omitempty"`
+
+	// Optional. Sends the message silently. Users will receive a
+	// notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageID int `json:"reply_to_message_id,omitempty"`
+
+	// Optional. Additional interface options.
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+func (r SendDiceRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "sendDice", r)
+}
+
+func (r SendDiceRequest) messageChatID() interface{} {
+	return r.ChatID
+}
+
+// SendChatActionRequest tells the user that something is happening on the
+// bot's side. The status is set for 5 seconds or less (when a message
+// arrives from your bot, Telegram clients clear its typing status). Returns
+// True on success.
+//
+// Use Bot.KeepChatAction to keep showing a chat action while a long-running
+// operation is in progress.
+type SendChatActionRequest struct {
+	// Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername)
+	ChatID interface{} `json:"chat_id"`
+
+	// Type of action to broadcast, one of the ChatAction constants.
+	Action string `json:"action"`
+}
+
+func (r SendChatActionRequest) doWith(ctx context.Context, bot Bot) (Response, error) {
+	return bot.doJSON(ctx, "sendChatAction", r)
 }
@@ -3,6 +3,7 @@ package ted
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -217,3 +218,122 @@ func TestInlineQueryResultLocation_MarshalJSON(t *testing.T) {
   "title": "Title"
 }`, string(JSON))
 }
+
+func TestBanChatMemberRequest_MarshalJSON(t *testing.T) {
+	JSON, err := json.Marshal(BanChatMemberRequest{
+		ChatID: -1001234,
+		UserID: 5678,
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"chat_id":-1001234,"user_id":5678}`, string(JSON))
+	JSON, err = json.Marshal(BanChatMemberRequest{
+		ChatID:         -1001234,
+		UserID:         5678,
+		UntilDate:      time.Unix(1600000000, 0),
+		RevokeMessages: true,
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"chat_id":-1001234,"user_id":5678,"until_date":1600000000,"revoke_messages":true}`, string(JSON))
+}
+
+func TestRestrictChatMemberRequest_MarshalJSON(t *testing.T) {
+	JSON, err := json.Marshal(RestrictChatMemberRequest{
+		ChatID: "@group",
+		UserID: 5678,
+		Permissions: ChatPermissions{
+			CanSendMessages: true,
+		},
+		UntilDate: time.Unix(1600000000, 0),
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+  "chat_id": "@group",
+  "user_id": 5678,
+  "permissions": {
+    "can_send_messages": true,
+    "can_send_media_messages": false,
+    "can_send_polls": false,
+    "can_send_other_messages": false,
+    "can_add_web_page_previews": false,
+    "can_change_info": false,
+    "can_invite_users": false,
+    "can_pin_messages": false
+  },
+  "until_date": 1600000000
+}`, string(JSON))
+}
+
+func TestPromoteChatMemberRequest_MarshalJSON(t *testing.T) {
+	JSON, err := json.Marshal(PromoteChatMemberRequest{
+		ChatID:             -1001234,
+		UserID:             5678,
+		CanDeleteMessages:  true,
+		CanRestrictMembers: true,
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"chat_id":-1001234,"user_id":5678,"can_delete_messages":true,"can_restrict_members":true}`, string(JSON))
+}
+
+func TestCreateChatInviteLinkRequest_MarshalJSON(t *testing.T) {
+	JSON, err := json.Marshal(CreateChatInviteLinkRequest{
+		ChatID:             -1001234,
+		Name:               "Captcha",
+		ExpireDate:         time.Unix(1600000000, 0),
+		CreatesJoinRequest: true,
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"chat_id":-1001234,"name":"Captcha","expire_date":1600000000,"creates_join_request":true}`, string(JSON))
+}
+
+func TestEditChatInviteLinkRequest_MarshalJSON(t *testing.T) {
+	JSON, err := json.Marshal(EditChatInviteLinkRequest{
+		ChatID:      -1001234,
+		InviteLink:  "https://t.me/+abc",
+		MemberLimit: 10,
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"chat_id":-1001234,"invite_link":"https://t.me/+abc","member_limit":10}`, string(JSON))
+}
+
+func TestSendPollRequest_MarshalJSON(t *testing.T) {
+	t.Run("regular poll", func(t *testing.T) {
+		anonymous := false
+		JSON, err := json.Marshal(SendPollRequest{
+			ChatID:                -1001234,
+			Question:              "Standup time?",
+			Options:               []string{"9:00", "9:30", "10:00"},
+			IsAnonymous:           &anonymous,
+			AllowsMultipleAnswers: true,
+			CloseDate:             time.Unix(1600000000, 0),
+		})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{
+  "chat_id": -1001234,
+  "question": "Standup time?",
+  "options": ["9:00", "9:30", "10:00"],
+  "is_anonymous": false,
+  "allows_multiple_answers": true,
+  "close_date": 1600000000
+}`, string(JSON))
+	})
+	t.Run("quiz", func(t *testing.T) {
+		JSON, err := json.Marshal(SendPollRequest{
+			ChatID:      123,
+			Question:    "2 + 2?",
+			Options:     []string{"4", "5"},
+			Type:        PollQuiz,
+			Explanation: "Basic arithmetic",
+			OpenPeriod:  60,
+		})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{
+  "chat_id": 123,
+  "question": "2 + 2?",
+  "options": ["4", "5"],
+  "type": "quiz",
+  "correct_option_id": 0,
+  "explanation": "Basic arithmetic",
+  "open_period": 60
+}`, string(JSON))
+	})
+}
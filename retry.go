@@ -0,0 +1,127 @@
+package ted
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"net"
+	"time"
+)
+
+// A RetryPolicy decides whether a failed request to the Bot API should be
+// retried.
+type RetryPolicy interface {
+	// Retry is called after the attempt'th attempt at making a request,
+	// counting from 1, fails with err. It reports whether the request
+	// should be retried and how long to wait before doing so.
+	Retry(attempt int, err error) (time.Duration, bool)
+}
+
+// DefaultRetryPolicy is used by bots without a RetryPolicy. It retries
+// requests up to 3 times, waiting one second between attempts or longer if
+// Telegram's flood control requires it.
+//
+// Requests rejected by flood control, requests which failed while connecting
+// to the Bot API and requests which failed with a temporary network error are
+// retried. Server errors are not retried, since retrying a request which
+// sends a message could send it twice. Use a Backoff with the error codes to
+// retry to opt in to retrying them, and with UnsentOnly set to stop retrying
+// network errors after a request may have been sent.
+var DefaultRetryPolicy RetryPolicy = Backoff{
+	MaxRetries: 3,
+	Delay:      1 * time.Second,
+	ErrorCodes: []int{429},
+}
+
+// Backoff is a RetryPolicy which retries temporary network errors and errors
+// returned by Telegram with selected error codes, optionally waiting longer
+// after each attempt.
+type Backoff struct {
+	// MaxRetries is the maximum number of times a request will be
+	// retried.
+	MaxRetries int
+
+	// Delay is how long to wait before the first retry.
+	Delay time.Duration
+
+	// Multiplier is the factor the delay is increased by after each retry.
+	// Values less than or equal to 1 result in a constant delay.
+	Multiplier float64
+
+	// MaxDelay, if positive, is the maximum delay between retries.
+	MaxDelay time.Duration
+
+	// Jitter is the fraction of each delay, between 0 and 1, which is
+	// randomised. For example, with a jitter of 0.5, the actual delay will
+	// be between half and all of the computed delay.
+	Jitter float64
+
+	// ErrorCodes contains the Telegram error codes which should be
+	// retried. When Telegram specifies how long to wait before repeating a
+	// request in APIError.RetryAfter, the delay will be at least that long.
+	ErrorCodes []int
+
+	// UnsentOnly limits the network errors which are retried to those which
+	// happened while connecting to the Bot API, before the request was sent.
+	// Otherwise, all temporary network errors are retried.
+	UnsentOnly bool
+}
+
+// Retry implements RetryPolicy.
+func (b Backoff) Retry(attempt int, err error) (time.Duration, bool) {
+	if attempt > b.MaxRetries {
+		return 0, false
+	}
+	delay := b.delay(attempt)
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		if !b.retryable(apiErr.ErrorCode) {
+			return 0, false
+		}
+		retryAfter := time.Duration(apiErr.RetryAfter) * time.Second
+		if delay < retryAfter {
+			delay = retryAfter
+		}
+		return delay, true
+	}
+	if unsent(err) {
+		return delay, true
+	}
+	var terr temporaryError
+	if b.UnsentOnly || !errors.As(err, &terr) || !terr.Temporary() {
+		return 0, false
+	}
+	return delay, true
+}
+
+// unsent reports whether err happened while connecting to the Bot API, so
+// that the request cannot have been received by Telegram.
+func unsent(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// delay returns how long to wait after the attempt'th attempt, before
+// considering any delay requested by Telegram.
+func (b Backoff) delay(attempt int) time.Duration {
+	delay := float64(b.Delay)
+	if b.Multiplier > 1 {
+		delay *= math.Pow(b.Multiplier, float64(attempt-1))
+	}
+	if b.MaxDelay > 0 && delay > float64(b.MaxDelay) {
+		delay = float64(b.MaxDelay)
+	}
+	if b.Jitter > 0 {
+		delay -= b.Jitter * rand.Float64() * delay
+	}
+	return time.Duration(delay)
+}
+
+func (b Backoff) retryable(errorCode int) bool {
+	for _, code := range b.ErrorCodes {
+		if code == errorCode {
+			return true
+		}
+	}
+	return false
+}
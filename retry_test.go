@@ -0,0 +1,149 @@
+package ted
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoff_Retry(t *testing.T) {
+	policy := Backoff{
+		MaxRetries: 3,
+		Delay:      1 * time.Second,
+		Multiplier: 2,
+		MaxDelay:   3 * time.Second,
+		ErrorCodes: []int{429, 502},
+	}
+	tests := []struct {
+		name      string
+		attempt   int
+		err       error
+		wantDelay time.Duration
+		wantRetry bool
+	}{
+		{
+			name:      "temporary error",
+			attempt:   1,
+			err:       tempError{},
+			wantDelay: 1 * time.Second,
+			wantRetry: true,
+		},
+		{
+			name:      "increases delay after each attempt",
+			attempt:   2,
+			err:       tempError{},
+			wantDelay: 2 * time.Second,
+			wantRetry: true,
+		},
+		{
+			name:      "limits delay to max delay",
+			attempt:   3,
+			err:       tempError{},
+			wantDelay: 3 * time.Second,
+			wantRetry: true,
+		},
+		{
+			name:    "stops after max retries",
+			attempt: 4,
+			err:     tempError{},
+		},
+		{
+			name:      "retryable error code",
+			attempt:   1,
+			err:       &APIError{ErrorCode: 502},
+			wantDelay: 1 * time.Second,
+			wantRetry: true,
+		},
+		{
+			name:      "waits for retry after",
+			attempt:   1,
+			err:       &APIError{ErrorCode: 429, RetryAfter: 10},
+			wantDelay: 10 * time.Second,
+			wantRetry: true,
+		},
+		{
+			name:    "other error code",
+			attempt: 1,
+			err:     &APIError{ErrorCode: 400},
+		},
+		{
+			name:    "other error",
+			attempt: 1,
+			err:     errors.New("error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, retry := policy.Retry(tt.attempt, tt.err)
+			assert.Equal(t, tt.wantRetry, retry)
+			assert.Equal(t, tt.wantDelay, delay)
+		})
+	}
+}
+
+func TestBackoff_Retry_UnsentOnly(t *testing.T) {
+	policy := Backoff{
+		MaxRetries: 1,
+		Delay:      1 * time.Second,
+		UnsentOnly: true,
+	}
+	delay, retry := policy.Retry(1, &url.Error{Op: "Post", URL: "https://api.telegram.org", Err: dialError})
+	assert.True(t, retry)
+	assert.Equal(t, 1*time.Second, delay)
+	_, retry = policy.Retry(1, tempError{})
+	assert.False(t, retry)
+}
+
+func TestBackoff_Retry_Jitter(t *testing.T) {
+	policy := Backoff{
+		MaxRetries: 1,
+		Delay:      1 * time.Second,
+		Jitter:     0.5,
+	}
+	for i := 0; i < 100; i++ {
+		delay, retry := policy.Retry(1, tempError{})
+		assert.True(t, retry)
+		assert.True(t, delay >= 500*time.Millisecond && delay <= 1*time.Second, "delay out of range: %s", delay)
+	}
+}
+
+func TestBot_doReq_RetryPolicy(t *testing.T) {
+	client := &httpClient{
+		results: []result{
+			{
+				res: &http.Response{Body: ioutil.NopCloser(strings.NewReader(`{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 0","parameters":{"retry_after":0}}`))},
+			},
+			{
+				res: &http.Response{StatusCode: http.StatusBadGateway, Status: "502 Bad Gateway", Body: ioutil.NopCloser(strings.NewReader(`<html></html>`))},
+			},
+			{
+				res: &http.Response{Body: ioutil.NopCloser(strings.NewReader(`{"ok":true}`))},
+			},
+		},
+	}
+	bot := Bot{
+		HTTPClient: client,
+		RetryPolicy: Backoff{
+			MaxRetries: 2,
+			ErrorCodes: []int{429, 502},
+		},
+	}
+	req, err := http.NewRequest(http.MethodPost, "https://api.telegram.org/bot/sendMessage", strings.NewReader(`{"text":"hello"}`))
+	assert.NoError(t, err)
+	response, err := bot.doReq(context.Background(), req)
+	assert.NoError(t, err)
+	assert.True(t, response.OK)
+	assert.Len(t, client.requests, 3)
+	for _, req := range client.requests {
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"text":"hello"}`, string(body))
+	}
+}
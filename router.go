@@ -0,0 +1,163 @@
+package ted
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+// Router is an UpdateHandler which dispatches updates to other handlers
+// registered for bot commands, callback query data, inline queries, chosen
+// inline results, service messages and other kinds of updates. Updates which
+// do not match any registered handler are passed to the fallback handler, if
+// one was set.
+//
+// The zero value is an empty Router ready to use. Handlers should be
+// registered before the Router starts handling updates.
+type Router struct {
+	// Username is the bot's username, with or without a leading @. If set,
+	// commands addressed to other bots, such as /start@otherbot, are not
+	// matched by handlers registered with Command.
+	Username string
+
+	commands           map[string]UpdateHandler
+	callbacks          []callbackRoute
+	inlineQuery        UpdateHandler
+	chosenInlineResult UpdateHandler
+	serviceMessage     UpdateHandler
+	kinds              map[string]UpdateHandler
+	fallback           UpdateHandler
+}
+
+type callbackRoute struct {
+	match   func(data string) bool
+	handler UpdateHandler
+}
+
+// Command registers handler for messages beginning with the bot command
+// command, with or without its leading slash. Commands are matched using
+// Message.CommandAndArgs, so a command addressed to the bot with a mention,
+// such as /start@username, matches the command "start". Commands addressed to
+// other bots are ignored only if the Router's Username is set.
+func (r *Router) Command(command string, handler UpdateHandler) {
+	if r.commands == nil {
+		r.commands = make(map[string]UpdateHandler)
+	}
+	r.commands[strings.TrimPrefix(command, "/")] = handler
+}
+
+// CallbackPrefix registers handler for callback queries whose data begins
+// with prefix. Callback query handlers are tried in the order they were
+// registered.
+func (r *Router) CallbackPrefix(prefix string, handler UpdateHandler) {
+	r.callbacks = append(r.callbacks, callbackRoute{
+		match: func(data string) bool {
+			return strings.HasPrefix(data, prefix)
+		},
+		handler: handler,
+	})
+}
+
+// CallbackRegexp registers handler for callback queries whose data matches
+// re. Callback query handlers are tried in the order they were registered.
+func (r *Router) CallbackRegexp(re *regexp.Regexp, handler UpdateHandler) {
+	r.callbacks = append(r.callbacks, callbackRoute{
+		match:   re.MatchString,
+		handler: handler,
+	})
+}
+
+// InlineQuery registers handler for inline queries.
+func (r *Router) InlineQuery(handler UpdateHandler) {
+	r.inlineQuery = handler
+}
+
+// ChosenInlineResult registers handler for chosen inline results.
+func (r *Router) ChosenInlineResult(handler UpdateHandler) {
+	r.chosenInlineResult = handler
+}
+
+// ServiceMessage registers handler for messages which do not result from a
+// user directly interacting with the bot, such as members joining or leaving
+// a chat. See Message.IsDirectInteraction.
+func (r *Router) ServiceMessage(handler UpdateHandler) {
+	r.serviceMessage = handler
+}
+
+// Handle registers handler for updates of the given kind, which should be one
+// of the Update constants such as UpdateEditedMessage. Handlers registered for
+// more specific updates, such as commands, take precedence.
+func (r *Router) Handle(kind string, handler UpdateHandler) {
+	if r.kinds == nil {
+		r.kinds = make(map[string]UpdateHandler)
+	}
+	r.kinds[kind] = handler
+}
+
+// Fallback registers handler for updates not matched by any other handler.
+func (r *Router) Fallback(handler UpdateHandler) {
+	r.fallback = handler
+}
+
+// HandleUpdate dispatches update to the handler it matches. Updates which
+// match no handler are ignored if no fallback handler was set.
+func (r *Router) HandleUpdate(ctx context.Context, update Update) {
+	handler := r.handler(update)
+	if handler != nil {
+		handler.HandleUpdate(ctx, update)
+	}
+}
+
+// addressedToBot reports whether the command at the start of m is not
+// addressed to a bot other than the one with the Router's Username.
+func (r *Router) addressedToBot(m Message) bool {
+	if r.Username == "" {
+		return true
+	}
+	for _, e := range m.Entities {
+		if e.Type == "bot_command" && e.Offset == 0 {
+			command := m.Text[:e.Length]
+			mention := strings.Index(command, "@")
+			if mention == -1 {
+				return true
+			}
+			return strings.EqualFold(command[mention+1:], strings.TrimPrefix(r.Username, "@"))
+		}
+	}
+	return true
+}
+
+// handler returns the handler which update should be dispatched to.
+func (r *Router) handler(update Update) UpdateHandler {
+	switch {
+	case update.Message != nil:
+		if !update.Message.IsDirectInteraction() {
+			if r.serviceMessage != nil {
+				return r.serviceMessage
+			}
+			break
+		}
+		command, _ := update.Message.CommandAndArgs()
+		if handler, ok := r.commands[command]; ok && command != "" && r.addressedToBot(*update.Message) {
+			return handler
+		}
+	case update.CallbackQuery != nil:
+		for _, route := range r.callbacks {
+			if route.match(update.CallbackQuery.Data) {
+				return route.handler
+			}
+		}
+	case update.InlineQuery != nil:
+		if r.inlineQuery != nil {
+			return r.inlineQuery
+		}
+	case update.ChosenInlineResult != nil:
+		if r.chosenInlineResult != nil {
+			return r.chosenInlineResult
+		}
+	}
+	if handler, ok := r.kinds[update.Kind()]; ok {
+		return handler
+	}
+	return r.fallback
+}
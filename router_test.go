@@ -0,0 +1,196 @@
+package ted
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouter_HandleUpdate(t *testing.T) {
+	var handled string
+	handler := func(name string) UpdateHandler {
+		return UpdateHandlerFunc(func(ctx context.Context, update Update) {
+			handled = name
+		})
+	}
+	var router Router
+	router.Command("/start", handler("start"))
+	router.Command("help", handler("help"))
+	router.CallbackPrefix("vote:", handler("vote"))
+	router.CallbackRegexp(regexp.MustCompile(`^page:\d+$`), handler("page"))
+	router.InlineQuery(handler("inline query"))
+	router.ChosenInlineResult(handler("chosen inline result"))
+	router.ServiceMessage(handler("service message"))
+	router.Fallback(handler("fallback"))
+
+	command := func(text string, length int) *Message {
+		return &Message{
+			Text:     text,
+			Entities: []MessageEntity{{Type: "bot_command", Length: length}},
+		}
+	}
+	tests := []struct {
+		name   string
+		update Update
+		want   string
+	}{
+		{
+			name:   "command",
+			update: Update{Message: command("/start", 6)},
+			want:   "start",
+		},
+		{
+			name:   "command with mention and args",
+			update: Update{Message: command("/help@username topic", 14)},
+			want:   "help",
+		},
+		{
+			name:   "unregistered command",
+			update: Update{Message: command("/stop", 5)},
+			want:   "fallback",
+		},
+		{
+			name:   "text message",
+			update: Update{Message: &Message{Text: "hello"}},
+			want:   "fallback",
+		},
+		{
+			name:   "service message",
+			update: Update{Message: &Message{LeftChatMember: &User{}}},
+			want:   "service message",
+		},
+		{
+			name:   "callback query prefix",
+			update: Update{CallbackQuery: &CallbackQuery{Data: "vote:yes"}},
+			want:   "vote",
+		},
+		{
+			name:   "callback query regexp",
+			update: Update{CallbackQuery: &CallbackQuery{Data: "page:2"}},
+			want:   "page",
+		},
+		{
+			name:   "unmatched callback query",
+			update: Update{CallbackQuery: &CallbackQuery{Data: "page:next"}},
+			want:   "fallback",
+		},
+		{
+			name:   "inline query",
+			update: Update{InlineQuery: &InlineQuery{}},
+			want:   "inline query",
+		},
+		{
+			name:   "chosen inline result",
+			update: Update{ChosenInlineResult: &ChosenInlineResult{}},
+			want:   "chosen inline result",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handled = ""
+			router.HandleUpdate(context.Background(), tt.update)
+			assert.Equal(t, tt.want, handled)
+		})
+	}
+}
+
+func TestRouter_Handle(t *testing.T) {
+	var handled string
+	handler := func(name string) UpdateHandler {
+		return UpdateHandlerFunc(func(ctx context.Context, update Update) {
+			handled = name
+		})
+	}
+	var router Router
+	router.Command("start", handler("start"))
+	router.ServiceMessage(handler("service message"))
+	router.Handle(UpdateMessage, handler("message"))
+	router.Handle(UpdateEditedMessage, handler("edited message"))
+	router.Fallback(handler("fallback"))
+
+	tests := []struct {
+		name   string
+		update Update
+		want   string
+	}{
+		{
+			name: "command takes precedence",
+			update: Update{Message: &Message{
+				Text:     "/start",
+				Entities: []MessageEntity{{Type: "bot_command", Length: 6}},
+			}},
+			want: "start",
+		},
+		{
+			name:   "service message takes precedence",
+			update: Update{Message: &Message{LeftChatMember: &User{}}},
+			want:   "service message",
+		},
+		{
+			name: "unregistered command",
+			update: Update{Message: &Message{
+				Text:     "/stop",
+				Entities: []MessageEntity{{Type: "bot_command", Length: 5}},
+			}},
+			want: "message",
+		},
+		{
+			name:   "text message",
+			update: Update{Message: &Message{Text: "hello"}},
+			want:   "message",
+		},
+		{
+			name:   "edited message",
+			update: Update{EditedMessage: &Message{Text: "hello"}},
+			want:   "edited message",
+		},
+		{
+			name:   "unregistered kind",
+			update: Update{ChannelPost: &Message{Text: "hello"}},
+			want:   "fallback",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handled = ""
+			router.HandleUpdate(context.Background(), tt.update)
+			assert.Equal(t, tt.want, handled)
+		})
+	}
+}
+
+func TestRouter_HandleUpdate_WithoutFallback(t *testing.T) {
+	var router Router
+	router.HandleUpdate(context.Background(), Update{Message: &Message{Text: "hello"}})
+}
+
+func TestRouter_HandleUpdate_Username(t *testing.T) {
+	var handled string
+	router := Router{Username: "@TedBot"}
+	router.Command("start", UpdateHandlerFunc(func(ctx context.Context, update Update) {
+		handled = "start"
+	}))
+	router.Fallback(UpdateHandlerFunc(func(ctx context.Context, update Update) {
+		handled = "fallback"
+	}))
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"/start", "start"},
+		{"/start@tedbot", "start"},
+		{"/start@otherbot", "fallback"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			handled = ""
+			router.HandleUpdate(context.Background(), Update{Message: &Message{
+				Text:     tt.text,
+				Entities: []MessageEntity{{Type: "bot_command", Length: len(tt.text)}},
+			}})
+			assert.Equal(t, tt.want, handled)
+		})
+	}
+}
@@ -2,17 +2,16 @@ package ted
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
-	"strings"
-	"sync"
 	"time"
 )
 
 type Request interface {
-	doWith(bot Bot) (Response, error)
+	doWith(ctx context.Context, bot Bot) (Response, error)
 }
 
 type HTTPClient interface {
@@ -22,10 +21,58 @@ type HTTPClient interface {
 type Bot struct {
 	Token      string
 	HTTPClient HTTPClient
+
+	// RetryPolicy decides whether failed requests should be retried. If
+	// nil, DefaultRetryPolicy is used.
+	RetryPolicy RetryPolicy
+
+	// Limiter, if not nil, is used to delay requests which send messages to
+	// stay within Telegram's rate limits.
+	Limiter Limiter
+
+	// Endpoint configures the Bot API server requests are made to.
+	Endpoint Endpoint
+
+	// FollowMigrations makes requests to groups which have been migrated to
+	// supergroups be made again to the supergroup.
+	FollowMigrations bool
+
+	// OnChatMigrated, if not nil, is called when a request fails because the
+	// group it was made to has been migrated to a supergroup, so that stored
+	// chat IDs can be updated.
+	OnChatMigrated func(oldChatID, newChatID int64)
 }
 
 func (b Bot) Do(request Request) (Response, error) {
-	return request.doWith(b)
+	return b.DoContext(context.Background(), request)
+}
+
+// DoContext makes request with the provided context. If ctx is cancelled or
+// its deadline is exceeded while the request is in progress or waiting to
+// be retried, DoContext returns ctx.Err().
+//
+// If request fails because it was made to a group which has been migrated to
+// a supergroup, the bot's OnChatMigrated hook is called and, if
+// FollowMigrations is set, request is made again to the supergroup.
+func (b Bot) DoContext(ctx context.Context, request Request) (Response, error) {
+	res, err := b.do(ctx, request)
+	if apiErr, ok := isMigration(err); ok {
+		return b.followMigration(ctx, request, apiErr)
+	}
+	return res, err
+}
+
+// do makes request once the bot's Limiter allows it.
+func (b Bot) do(ctx context.Context, request Request) (Response, error) {
+	if b.Limiter != nil {
+		if chatID, ok := requestChatID(request); ok {
+			err := b.Limiter.Wait(ctx, chatID)
+			if err != nil {
+				return Response{}, err
+			}
+		}
+	}
+	return request.doWith(ctx, b)
 }
 
 type MultiError []error
@@ -41,28 +88,24 @@ func (m MultiError) Error() string {
 	return fmt.Sprintf("%d out of %d requests were unsuccessful", errored, total)
 }
 
+// DoMulti makes multiple requests concurrently and waits for all of them to
+// complete. See DoMultiContext.
 func (b Bot) DoMulti(requests ...Request) ([]Response, error) {
-	responses := make([]Response, len(requests))
-	errs := make([]error, len(requests))
-	var wg sync.WaitGroup
-	wg.Add(len(requests))
-	for i, request := range requests {
-		go func(i int, req Request) {
-			res, err := b.Do(req)
-			if err != nil {
-				errs[i] = err
-			} else {
-				responses[i] = res
-			}
-			wg.Done()
-		}(i, request)
-	}
-	for _, err := range errs {
-		if err != nil {
-			return nil, MultiError(errs)
-		}
+	return b.DoMultiContext(context.Background(), requests...)
+}
+
+// DoMultiContext makes multiple requests concurrently with the provided
+// context and returns their responses in the same order as requests. If any
+// requests were unsuccessful, err will be a MultiError and the responses to
+// the unsuccessful requests will be empty. Use DoBatch to limit concurrency
+// or keep requests to the same chat in order.
+func (b Bot) DoMultiContext(ctx context.Context, requests ...Request) ([]Response, error) {
+	results, err := b.DoBatch(ctx, BatchOptions{}, requests...)
+	responses := make([]Response, len(results))
+	for i, result := range results {
+		responses[i] = result.Response
 	}
-	return responses, nil
+	return responses, err
 }
 
 type Response struct {
@@ -91,71 +134,117 @@ type temporaryError interface {
 	Temporary() bool
 }
 
-// doReq makes the provided http.Request to the Telegram Bot API.
-// Currently hardcoded to retry up to 3 times if the error is retryable (implements Temporary() bool).
-func (b Bot) doReq(req *http.Request) (Response, error) {
-	retries := 3
-	var res *http.Response
-	var err error
-	for {
-		res, err = b.HTTPClient.Do(req)
-		if err != nil {
-			if terr, ok := err.(temporaryError); ok && terr.Temporary() && retries > 0 {
-				retries--
-				time.Sleep(1 * time.Second)
-				continue
-			}
+// sleep pauses the current goroutine for at least the duration d or until ctx
+// is done, and reports whether the full duration elapsed.
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// doReq makes the provided http.Request to the Telegram Bot API, retrying it
+// according to the bot's RetryPolicy. Retries are abandoned if ctx is done.
+// Requests with a body that cannot be replayed are never retried.
+func (b Bot) doReq(ctx context.Context, req *http.Request) (Response, error) {
+	policy := b.RetryPolicy
+	if policy == nil {
+		policy = DefaultRetryPolicy
+	}
+	for attempt := 1; ; attempt++ {
+		response, err := b.try(req)
+		if err == nil {
+			return response, nil
+		}
+		if ctx.Err() != nil {
+			return Response{}, ctx.Err()
+		}
+		delay, retry := policy.Retry(attempt, err)
+		if !retry {
+			return Response{}, err
+		}
+		req, retry = rewind(req)
+		if !retry {
 			return Response{}, err
 		}
-		break
+		if !sleep(ctx, delay) {
+			return Response{}, ctx.Err()
+		}
+	}
+}
+
+// try makes a single attempt at req.
+func (b Bot) try(req *http.Request) (Response, error) {
+	res, err := b.HTTPClient.Do(req)
+	if err != nil {
+		return Response{}, err
 	}
 	defer res.Body.Close()
 	var response Response
 	err = json.NewDecoder(res.Body).Decode(&response)
 	if err != nil {
+		if res.StatusCode >= http.StatusInternalServerError {
+			// server errors may come from a proxy in front of the Bot API
+			// without a JSON body
+			return Response{}, &APIError{ErrorCode: res.StatusCode, Description: res.Status}
+		}
 		return Response{}, err
 	}
 	if !response.OK {
-		return Response{}, response
+		return Response{}, response.apiError()
 	}
 	return response, nil
 }
 
+// rewind returns a copy of req which can be made again, or false if req has
+// a body which cannot be replayed.
+func rewind(req *http.Request) (*http.Request, bool) {
+	if req == nil || req.Body == nil || req.Body == http.NoBody {
+		return req, true
+	}
+	if req.GetBody == nil {
+		return nil, false
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, false
+	}
+	req = req.Clone(req.Context())
+	req.Body = body
+	return req, true
+}
+
 // doQuery makes a GET request to the Telegram Bot API with URL query parameters.
-func (b Bot) doQuery(method string, params map[string]interface{}) (Response, error) {
+func (b Bot) doQuery(ctx context.Context, method string, params map[string]interface{}) (Response, error) {
 	form := url.Values{}
 	for k, v := range params {
 		form.Set(k, fmt.Sprintf("%v", v))
 	}
-	u := fmt.Sprintf("https://api.telegram.org/bot%s/%s", b.Token, method)
-	req, err := http.NewRequest(http.MethodPost, u, nil)
+	u := b.Endpoint.methodURL(b.Token, method)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, nil)
 	if err != nil {
 		return Response{}, err
 	}
 	req.URL.RawQuery = form.Encode()
-	return b.doReq(req)
+	return b.doReq(ctx, req)
 }
 
 // doJSON makes a POST request to the Telegram Bot API with a JSON body.
-func (b Bot) doJSON(method string, request interface{}) (Response, error) {
-	u := fmt.Sprintf("https://api.telegram.org/bot%s/%s", b.Token, method)
+func (b Bot) doJSON(ctx context.Context, method string, request interface{}) (Response, error) {
+	u := b.Endpoint.methodURL(b.Token, method)
 	var body bytes.Buffer
 	err := json.NewEncoder(&body).Encode(request)
 	if err != nil {
 		return Response{}, err
 	}
-	req, err := http.NewRequest(http.MethodPost, u, &body)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, &body)
 	if err != nil {
 		return Response{}, err
 	}
 	req.Header.Set("Content-Type", "application/json")
-	return b.doReq(req)
-}
-
-func IsMessageNotModified(err error) bool {
-	res, ok := err.(Response)
-	if !ok {
-		return false
-	}
-	return strings.Contains(res.Description, "message is not modified")
+	return b.doReq(ctx, req)
 }
@@ -1,7 +1,10 @@
 package ted
 
 import (
+	"context"
+	"errors"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"strings"
 	"testing"
@@ -29,16 +32,32 @@ func (r tempError) Temporary() bool {
 	return true
 }
 
+// dialError is returned when connecting to the Bot API fails.
+var dialError = &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+
 type result struct {
 	res *http.Response
 	err error
 }
 
+// okResponse returns a successful response from Telegram with data as its
+// result.
+func okResponse(data string) result {
+	return result{
+		res: &http.Response{Body: ioutil.NopCloser(strings.NewReader(`{"ok":true,"result":` + data + `}`))},
+	}
+}
+
 type httpClient struct {
-	results []result
+	results  []result
+	requests []*http.Request
 }
 
-func (h *httpClient) Do(*http.Request) (*http.Response, error) {
+func (h *httpClient) Do(req *http.Request) (*http.Response, error) {
+	h.requests = append(h.requests, req)
+	if len(h.results) == 0 {
+		return nil, errors.New("no more results")
+	}
 	var result result
 	result, h.results = h.results[0], h.results[1:]
 	return result.res, result.err
@@ -63,7 +82,7 @@ func TestBot_doReq(t *testing.T) {
 			},
 		}
 		bot := Bot{HTTPClient: client}
-		response, err := bot.doReq(nil)
+		response, err := bot.doReq(context.Background(), nil)
 		assert.NoError(t, err)
 		assert.True(t, response.OK)
 	})
@@ -85,7 +104,54 @@ func TestBot_doReq(t *testing.T) {
 			},
 		}
 		bot := Bot{HTTPClient: client}
-		_, err := bot.doReq(nil)
+		_, err := bot.doReq(context.Background(), nil)
+		assert.Error(t, err)
+	})
+	t.Run("abandons retries when context is done", func(t *testing.T) {
+		client := &httpClient{
+			results: []result{
+				{
+					err: tempError{},
+				},
+				{
+					res: &http.Response{Body: ioutil.NopCloser(strings.NewReader(`{"ok":true}`))},
+				},
+			},
+		}
+		bot := Bot{HTTPClient: client}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := bot.doReq(ctx, nil)
+		assert.Equal(t, context.Canceled, err)
+		assert.Len(t, client.requests, 1)
+	})
+	t.Run("retries errors while connecting by default", func(t *testing.T) {
+		client := &httpClient{
+			results: []result{
+				{
+					err: dialError,
+				},
+				{
+					res: &http.Response{Body: ioutil.NopCloser(strings.NewReader(`{"ok":true}`))},
+				},
+			},
+		}
+		bot := Bot{HTTPClient: client}
+		_, err := bot.doReq(context.Background(), nil)
+		assert.NoError(t, err)
+		assert.Len(t, client.requests, 2)
+	})
+	t.Run("does not retry server errors by default", func(t *testing.T) {
+		client := &httpClient{
+			results: []result{
+				{
+					res: &http.Response{StatusCode: http.StatusBadGateway, Status: "502 Bad Gateway", Body: ioutil.NopCloser(strings.NewReader(`<html></html>`))},
+				},
+			},
+		}
+		bot := Bot{HTTPClient: client}
+		_, err := bot.doReq(context.Background(), nil)
 		assert.Error(t, err)
+		assert.Len(t, client.requests, 1)
 	})
 }
@@ -1,15 +1,112 @@
 package ted
 
 import (
+	"encoding/json"
 	"strings"
 )
 
+// Update types, as returned by Update.Kind and used in lists of allowed
+// updates in GetUpdatesRequest and SetWebhookRequest.
+const (
+	UpdateMessage            = "message"
+	UpdateEditedMessage      = "edited_message"
+	UpdateChannelPost        = "channel_post"
+	UpdateEditedChannelPost  = "edited_channel_post"
+	UpdateInlineQuery        = "inline_query"
+	UpdateChosenInlineResult = "chosen_inline_result"
+	UpdateCallbackQuery      = "callback_query"
+	UpdateShippingQuery      = "shipping_query"
+	UpdatePreCheckoutQuery   = "pre_checkout_query"
+	UpdatePoll               = "poll"
+	UpdatePollAnswer         = "poll_answer"
+	UpdateMyChatMember       = "my_chat_member"
+	UpdateChatMember         = "chat_member"
+	UpdateChatJoinRequest    = "chat_join_request"
+)
+
+// Update represents an incoming update. At most one of the optional fields
+// can be present in any given update.
 type Update struct {
-	ID                 int                 `json:"update_id"`
-	Message            *Message            `json:"message"`
-	CallbackQuery      *CallbackQuery      `json:"callback_query"`
-	InlineQuery        *InlineQuery        `json:"inline_query"`
+	// The update's unique identifier
+	ID int `json:"update_id"`
+
+	// Optional. New incoming message of any kind — text, photo, sticker, etc.
+	Message *Message `json:"message"`
+
+	// Optional. New version of a message that is known to the bot and was edited
+	EditedMessage *Message `json:"edited_message"`
+
+	// Optional. New incoming channel post of any kind — text, photo, sticker, etc.
+	ChannelPost *Message `json:"channel_post"`
+
+	// Optional. New version of a channel post that is known to the bot and was edited
+	EditedChannelPost *Message `json:"edited_channel_post"`
+
+	// Optional. New incoming inline query
+	InlineQuery *InlineQuery `json:"inline_query"`
+
+	// Optional. The result of an inline query that was chosen by a user and sent to their chat partner.
 	ChosenInlineResult *ChosenInlineResult `json:"chosen_inline_result"`
+
+	// Optional. New incoming callback query
+	CallbackQuery *CallbackQuery `json:"callback_query"`
+
+	// Optional. New incoming shipping query. Only for invoices with flexible price
+	ShippingQuery *ShippingQuery `json:"shipping_query"`
+
+	// Optional. New incoming pre-checkout query. Contains full information about checkout
+	PreCheckoutQuery *PreCheckoutQuery `json:"pre_checkout_query"`
+
+	// Optional. New poll state. Bots receive only updates about stopped polls and polls, which are sent by the bot
+	Poll *Poll `json:"poll"`
+
+	// Optional. A user changed their answer in a non-anonymous poll. Bots receive new votes only in polls that were sent by the bot itself.
+	PollAnswer *PollAnswer `json:"poll_answer"`
+
+	// Optional. The bot's chat member status was updated in a chat. For private chats, this update is received only when the bot is blocked or unblocked by the user.
+	MyChatMember *ChatMemberUpdated `json:"my_chat_member"`
+
+	// Optional. A chat member's status was updated in a chat. The bot must be an administrator in the chat and must explicitly specify "chat_member" in the list of allowed updates to receive these updates.
+	ChatMember *ChatMemberUpdated `json:"chat_member"`
+
+	// Optional. A request to join the chat has been sent. The bot must have the can_invite_users administrator right in the chat to receive these updates.
+	ChatJoinRequest *ChatJoinRequest `json:"chat_join_request"`
+}
+
+// Kind returns the type of the update, which is one of the Update constants,
+// or an empty string if the update does not contain any known type.
+func (u Update) Kind() string {
+	switch {
+	case u.Message != nil:
+		return UpdateMessage
+	case u.EditedMessage != nil:
+		return UpdateEditedMessage
+	case u.ChannelPost != nil:
+		return UpdateChannelPost
+	case u.EditedChannelPost != nil:
+		return UpdateEditedChannelPost
+	case u.InlineQuery != nil:
+		return UpdateInlineQuery
+	case u.ChosenInlineResult != nil:
+		return UpdateChosenInlineResult
+	case u.CallbackQuery != nil:
+		return UpdateCallbackQuery
+	case u.ShippingQuery != nil:
+		return UpdateShippingQuery
+	case u.PreCheckoutQuery != nil:
+		return UpdatePreCheckoutQuery
+	case u.Poll != nil:
+		return UpdatePoll
+	case u.PollAnswer != nil:
+		return UpdatePollAnswer
+	case u.MyChatMember != nil:
+		return UpdateMyChatMember
+	case u.ChatMember != nil:
+		return UpdateChatMember
+	case u.ChatJoinRequest != nil:
+		return UpdateChatJoinRequest
+	}
+	return ""
 }
 
 type Message struct {
@@ -25,6 +122,29 @@ type Message struct {
 	// Conversation the message belongs to
 	Chat Chat `json:"chat"`
 
+	// Optional. For forwarded messages, sender of the original message
+	ForwardFrom *User `json:"forward_from"`
+
+	// Optional. For messages forwarded from channels, information about the
+	// original channel
+	ForwardFromChat *Chat `json:"forward_from_chat"`
+
+	// Optional. For messages forwarded from channels, identifier of the
+	// original message in the channel
+	ForwardFromMessageID int `json:"forward_from_message_id"`
+
+	// Optional. For messages forwarded from channels, signature of the post
+	// author if present
+	ForwardSignature string `json:"forward_signature"`
+
+	// Optional. Sender's name for messages forwarded from users who
+	// disallow adding a link to their account in forwarded messages
+	ForwardSenderName string `json:"forward_sender_name"`
+
+	// Optional. For forwarded messages, date the original message was sent
+	// in Unix time
+	ForwardDate int `json:"forward_date"`
+
 	// For replies, the original message. Note that the Message object in
 	// this field will not contain further reply_to_message fields even if
 	// it itself is a reply.
@@ -33,6 +153,16 @@ type Message struct {
 	// Optional. Bot through which the message was sent
 	ViaBot *User `json:"via_bot"`
 
+	// Optional. Date the message was last edited in Unix time
+	EditDate int `json:"edit_date"`
+
+	// Optional. The unique identifier of a media message group this message
+	// belongs to
+	MediaGroupID string `json:"media_group_id"`
+
+	// Optional. Signature of the post author for messages in channels
+	AuthorSignature string `json:"author_signature"`
+
 	// For text messages, the actual UTF-8 text of the message, 0-4096 characters
 	Text string `json:"text"`
 
@@ -40,6 +170,51 @@ type Message struct {
 	// commands, etc. that appear in the text
 	Entities []MessageEntity `json:"entities"`
 
+	// Optional. Message is an animation, information about the animation.
+	// For backward compatibility, when this field is set, the Document field
+	// will also be set
+	Animation *Animation `json:"animation"`
+
+	// Optional. Message is an audio file, information about the file
+	Audio *Audio `json:"audio"`
+
+	// Optional. Message is a general file, information about the file
+	Document *Document `json:"document"`
+
+	// Optional. Message is a photo, available sizes of the photo
+	Photo []PhotoSize `json:"photo"`
+
+	// Optional. Message is a sticker, information about the sticker
+	Sticker *Sticker `json:"sticker"`
+
+	// Optional. Message is a video, information about the video
+	Video *Video `json:"video"`
+
+	// Optional. Message is a voice message, information about the file
+	Voice *Voice `json:"voice"`
+
+	// Optional. Caption for the animation, audio, document, photo, video or
+	// voice, 0-1024 characters
+	Caption string `json:"caption"`
+
+	// Optional. For messages with a caption, special entities like
+	// usernames, URLs, bot commands, etc. that appear in the caption
+	CaptionEntities []MessageEntity `json:"caption_entities"`
+
+	// Optional. Message is a shared contact, information about the contact
+	Contact *Contact `json:"contact"`
+
+	// Optional. Message is a dice with random value
+	Dice *Dice `json:"dice"`
+
+	// Optional. Message is a native poll, information about the poll
+	Poll *Poll `json:"poll"`
+
+	// Optional. Message is a venue, information about the venue. For
+	// backward compatibility, when this field is set, the Location field
+	// will also be set
+	Venue *Venue `json:"venue"`
+
 	// Optional. Message is a shared location, information about the location
 	Location *Location `json:"location"`
 
@@ -101,6 +276,10 @@ type Message struct {
 	// Optional. Service message. A user in the chat triggered another
 	// user's proximity alert while sharing Live Location.
 	ProximityAlertTriggered *ProximityAlertTriggered `json:"proximity_alert_triggered"`
+
+	// Optional. Inline keyboard attached to the message. login_url buttons
+	// are represented as ordinary url buttons.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup"`
 }
 
 // CommandAndArgs extracts and returns a Telegram bot command and the rest of
@@ -172,9 +351,71 @@ type User struct {
 	SupportsInlineQueries bool `json:"supports_inline_queries"`
 }
 
+// Chat represents a chat.
 type Chat struct {
-	ID   int64  `json:"id"`
+	// Unique identifier for this chat
+	ID int64 `json:"id"`
+
+	// Type of chat, can be either "private", "group", "supergroup" or "channel"
 	Type string `json:"type"`
+
+	// Optional. Title, for supergroups, channels and group chats
+	Title string `json:"title"`
+
+	// Optional. Username, for private chats, supergroups and channels if available
+	Username string `json:"username"`
+
+	// Optional. First name of the other party in a private chat
+	FirstName string `json:"first_name"`
+
+	// Optional. Last name of the other party in a private chat
+	LastName string `json:"last_name"`
+
+	// Optional. Description, for groups, supergroups and channel chats. Returned only in getChat.
+	Description string `json:"description"`
+
+	// Optional. Primary invite link, for groups, supergroups and channel chats. Returned only in getChat.
+	InviteLink string `json:"invite_link"`
+
+	// Optional. The most recent pinned message (by sending date). Returned only in getChat.
+	PinnedMessage *Message `json:"pinned_message"`
+
+	// Optional. Default chat member permissions, for groups and supergroups. Returned only in getChat.
+	Permissions *ChatPermissions `json:"permissions"`
+
+	// Optional. For supergroups, the minimum allowed delay between consecutive messages sent by each unprivileged user. Returned only in getChat.
+	SlowModeDelay int `json:"slow_mode_delay"`
+
+	// Optional. Unique identifier for the linked chat, i.e. the discussion group identifier for a channel and vice versa; for supergroups and channel chats. Returned only in getChat.
+	LinkedChatID int64 `json:"linked_chat_id"`
+}
+
+// ChatPermissions describes actions that a non-administrator user is allowed
+// to take in a chat.
+type ChatPermissions struct {
+	// True, if the user is allowed to send text messages, contacts, locations and venues
+	CanSendMessages bool `json:"can_send_messages"`
+
+	// True, if the user is allowed to send audios, documents, photos, videos, video notes and voice notes, implies CanSendMessages
+	CanSendMediaMessages bool `json:"can_send_media_messages"`
+
+	// True, if the user is allowed to send polls, implies CanSendMessages
+	CanSendPolls bool `json:"can_send_polls"`
+
+	// True, if the user is allowed to send animations, games, stickers and use inline bots, implies CanSendMediaMessages
+	CanSendOtherMessages bool `json:"can_send_other_messages"`
+
+	// True, if the user is allowed to add web page previews to their messages, implies CanSendMediaMessages
+	CanAddWebPagePreviews bool `json:"can_add_web_page_previews"`
+
+	// True, if the user is allowed to change the chat title, photo and other settings. Ignored in public supergroups
+	CanChangeInfo bool `json:"can_change_info"`
+
+	// True, if the user is allowed to invite new users to the chat
+	CanInviteUsers bool `json:"can_invite_users"`
+
+	// True, if the user is allowed to pin messages. Ignored in public supergroups
+	CanPinMessages bool `json:"can_pin_messages"`
 }
 
 type MessageEntity struct {
@@ -194,6 +435,22 @@ type CallbackQuery struct {
 type Location struct {
 	Longitude float32 `json:"longitude"`
 	Latitude  float32 `json:"latitude"`
+
+	// Optional. The radius of uncertainty for the location, measured in
+	// meters; 0-1500
+	HorizontalAccuracy float32 `json:"horizontal_accuracy"`
+
+	// Optional. Time relative to the message sending date, during which the
+	// location can be updated, in seconds. For active live locations only.
+	LivePeriod int `json:"live_period"`
+
+	// Optional. The direction in which user is moving, in degrees; 1-360.
+	// For active live locations only.
+	Heading int `json:"heading"`
+
+	// Optional. Maximum distance for proximity alerts about approaching
+	// another chat member, in meters. For sent live locations only.
+	ProximityAlertRadius int `json:"proximity_alert_radius"`
 }
 
 type InlineQuery struct {
@@ -267,3 +524,336 @@ type ProximityAlertTriggered struct {
 	Watcher  User `json:"watcher"`  // User that set the alert
 	Distance int  `json:"distance"` // The distance between the users
 }
+
+// File represents a file ready to be downloaded. The file can be downloaded
+// using Bot.DownloadFile. It is guaranteed that the download link will be
+// valid for at least 1 hour.
+type File struct {
+	// Identifier for this file, which can be used to download or reuse the file
+	FileID string `json:"file_id"`
+
+	// Unique identifier for this file, which is supposed to be the same over time and for different bots. Can't be used to download or reuse the file.
+	FileUniqueID string `json:"file_unique_id"`
+
+	// Optional. File size, if known
+	FileSize int `json:"file_size"`
+
+	// Optional. File path.
+	FilePath string `json:"file_path"`
+}
+
+// Animation represents an animation file (GIF or H.264/MPEG-4 AVC video without sound).
+type Animation struct {
+	// Identifier for this file, which can be used to download or reuse the file
+	FileID string `json:"file_id"`
+
+	// Unique identifier for this file, which is supposed to be the same over time and for different bots. Can't be used to download or reuse the file.
+	FileUniqueID string `json:"file_unique_id"`
+
+	Width    int        `json:"width"`     // Video width as defined by sender
+	Height   int        `json:"height"`    // Video height as defined by sender
+	Duration int        `json:"duration"`  // Duration of the video in seconds as defined by sender
+	Thumb    *PhotoSize `json:"thumb"`     // Optional. Animation thumbnail as defined by sender
+	FileName string     `json:"file_name"` // Optional. Original animation filename as defined by sender
+	MimeType string     `json:"mime_type"` // Optional. MIME type of the file as defined by sender
+	FileSize int        `json:"file_size"` // Optional. File size
+}
+
+// Audio represents an audio file to be treated as music by the Telegram clients.
+type Audio struct {
+	// Identifier for this file, which can be used to download or reuse the file
+	FileID string `json:"file_id"`
+
+	// Unique identifier for this file, which is supposed to be the same over time and for different bots. Can't be used to download or reuse the file.
+	FileUniqueID string `json:"file_unique_id"`
+
+	Duration  int        `json:"duration"`  // Duration of the audio in seconds as defined by sender
+	Performer string     `json:"performer"` // Optional. Performer of the audio as defined by sender or by audio tags
+	Title     string     `json:"title"`     // Optional. Title of the audio as defined by sender or by audio tags
+	FileName  string     `json:"file_name"` // Optional. Original filename as defined by sender
+	MimeType  string     `json:"mime_type"` // Optional. MIME type of the file as defined by sender
+	FileSize  int        `json:"file_size"` // Optional. File size
+	Thumb     *PhotoSize `json:"thumb"`     // Optional. Thumbnail of the album cover to which the music file belongs
+}
+
+// Document represents a general file (as opposed to photos, voice messages and audio files).
+type Document struct {
+	// Identifier for this file, which can be used to download or reuse the file
+	FileID string `json:"file_id"`
+
+	// Unique identifier for this file, which is supposed to be the same over time and for different bots. Can't be used to download or reuse the file.
+	FileUniqueID string `json:"file_unique_id"`
+
+	Thumb    *PhotoSize `json:"thumb"`     // Optional. Document thumbnail as defined by sender
+	FileName string     `json:"file_name"` // Optional. Original filename as defined by sender
+	MimeType string     `json:"mime_type"` // Optional. MIME type of the file as defined by sender
+	FileSize int        `json:"file_size"` // Optional. File size
+}
+
+// Sticker represents a sticker.
+type Sticker struct {
+	// Identifier for this file, which can be used to download or reuse the file
+	FileID string `json:"file_id"`
+
+	// Unique identifier for this file, which is supposed to be the same over time and for different bots. Can't be used to download or reuse the file.
+	FileUniqueID string `json:"file_unique_id"`
+
+	Width      int        `json:"width"`       // Sticker width
+	Height     int        `json:"height"`      // Sticker height
+	IsAnimated bool       `json:"is_animated"` // True, if the sticker is animated
+	Thumb      *PhotoSize `json:"thumb"`       // Optional. Sticker thumbnail in the .WEBP or .JPG format
+	Emoji      string     `json:"emoji"`       // Optional. Emoji associated with the sticker
+	SetName    string     `json:"set_name"`    // Optional. Name of the sticker set to which the sticker belongs
+	FileSize   int        `json:"file_size"`   // Optional. File size
+}
+
+// Video represents a video file.
+type Video struct {
+	// Identifier for this file, which can be used to download or reuse the file
+	FileID string `json:"file_id"`
+
+	// Unique identifier for this file, which is supposed to be the same over time and for different bots. Can't be used to download or reuse the file.
+	FileUniqueID string `json:"file_unique_id"`
+
+	Width    int        `json:"width"`     // Video width as defined by sender
+	Height   int        `json:"height"`    // Video height as defined by sender
+	Duration int        `json:"duration"`  // Duration of the video in seconds as defined by sender
+	Thumb    *PhotoSize `json:"thumb"`     // Optional. Video thumbnail
+	FileName string     `json:"file_name"` // Optional. Original filename as defined by sender
+	MimeType string     `json:"mime_type"` // Optional. Mime type of a file as defined by sender
+	FileSize int        `json:"file_size"` // Optional. File size
+}
+
+// Voice represents a voice note.
+type Voice struct {
+	// Identifier for this file, which can be used to download or reuse the file
+	FileID string `json:"file_id"`
+
+	// Unique identifier for this file, which is supposed to be the same over time and for different bots. Can't be used to download or reuse the file.
+	FileUniqueID string `json:"file_unique_id"`
+
+	Duration int    `json:"duration"`  // Duration of the audio in seconds as defined by sender
+	MimeType string `json:"mime_type"` // Optional. MIME type of the file as defined by sender
+	FileSize int    `json:"file_size"` // Optional. File size
+}
+
+// Contact represents a phone contact.
+type Contact struct {
+	PhoneNumber string `json:"phone_number"` // Contact's phone number
+	FirstName   string `json:"first_name"`   // Contact's first name
+	LastName    string `json:"last_name"`    // Optional. Contact's last name
+	UserID      int64  `json:"user_id"`      // Optional. Contact's user identifier in Telegram
+	VCard       string `json:"vcard"`        // Optional. Additional data about the contact in the form of a vCard
+}
+
+// Dice represents an animated emoji that displays a random value.
+type Dice struct {
+	Emoji string `json:"emoji"` // Emoji on which the dice throw animation is based
+	Value int    `json:"value"` // Value of the dice, 1-6 for “🎲” and “🎯” base emoji, 1-5 for “🏀” and “⚽” base emoji, 1-64 for “🎰” base emoji
+}
+
+// Venue represents a venue.
+type Venue struct {
+	Location       Location `json:"location"`        // Venue location
+	Title          string   `json:"title"`           // Name of the venue
+	Address        string   `json:"address"`         // Address of the venue
+	FoursquareID   string   `json:"foursquare_id"`   // Optional. Foursquare identifier of the venue
+	FoursquareType string   `json:"foursquare_type"` // Optional. Foursquare type of the venue
+}
+
+// PollOption contains information about one answer option in a poll.
+type PollOption struct {
+	Text       string `json:"text"`        // Option text, 1-100 characters
+	VoterCount int    `json:"voter_count"` // Number of users that voted for this option
+}
+
+// Poll contains information about a poll.
+type Poll struct {
+	// Unique poll identifier
+	ID string `json:"id"`
+
+	// Poll question, 1-300 characters
+	Question string `json:"question"`
+
+	// List of poll options
+	Options []PollOption `json:"options"`
+
+	// Total number of users that voted in the poll
+	TotalVoterCount int `json:"total_voter_count"`
+
+	// True, if the poll is closed
+	IsClosed bool `json:"is_closed"`
+
+	// True, if the poll is anonymous
+	IsAnonymous bool `json:"is_anonymous"`
+
+	// Poll type, currently can be "regular" or "quiz"
+	Type string `json:"type"`
+
+	// True, if the poll allows multiple answers
+	AllowsMultipleAnswers bool `json:"allows_multiple_answers"`
+
+	// Optional. 0-based identifier of the correct answer option. Available
+	// only for polls in the quiz mode, which are closed, or was sent (not
+	// forwarded) by the bot or to the private chat with the bot.
+	CorrectOptionID *int `json:"correct_option_id"`
+
+	// Optional. Text that is shown when a user chooses an incorrect answer
+	// or taps on the lamp icon in a quiz-style poll, 0-200 characters
+	Explanation string `json:"explanation"`
+
+	// Optional. Special entities like usernames, URLs, bot commands, etc.
+	// that appear in the explanation
+	ExplanationEntities []MessageEntity `json:"explanation_entities"`
+
+	// Optional. Amount of time in seconds the poll will be active after
+	// creation
+	OpenPeriod int `json:"open_period"`
+
+	// Optional. Point in time (Unix timestamp) when the poll will be
+	// automatically closed
+	CloseDate int `json:"close_date"`
+}
+
+// Poll types.
+const (
+	PollRegular = "regular"
+	PollQuiz    = "quiz"
+)
+
+// PollAnswer represents an answer of a user in a non-anonymous poll.
+type PollAnswer struct {
+	PollID    string `json:"poll_id"`    // Unique poll identifier
+	User      User   `json:"user"`       // The user, who changed the answer to the poll
+	OptionIDs []int  `json:"option_ids"` // 0-based identifiers of answer options, chosen by the user. May be empty if the user retracted their vote.
+}
+
+// ShippingAddress represents a shipping address.
+type ShippingAddress struct {
+	CountryCode string `json:"country_code"` // ISO 3166-1 alpha-2 country code
+	State       string `json:"state"`        // State, if applicable
+	City        string `json:"city"`         // City
+	StreetLine1 string `json:"street_line1"` // First line for the address
+	StreetLine2 string `json:"street_line2"` // Second line for the address
+	PostCode    string `json:"post_code"`    // Address post code
+}
+
+// OrderInfo represents information about an order.
+type OrderInfo struct {
+	Name            string           `json:"name"`             // Optional. User name
+	PhoneNumber     string           `json:"phone_number"`     // Optional. User's phone number
+	Email           string           `json:"email"`            // Optional. User email
+	ShippingAddress *ShippingAddress `json:"shipping_address"` // Optional. User shipping address
+}
+
+// ShippingQuery contains information about an incoming shipping query.
+type ShippingQuery struct {
+	ID              string          `json:"id"`               // Unique query identifier
+	From            User            `json:"from"`             // User who sent the query
+	InvoicePayload  string          `json:"invoice_payload"`  // Bot specified invoice payload
+	ShippingAddress ShippingAddress `json:"shipping_address"` // User specified shipping address
+}
+
+// PreCheckoutQuery contains information about an incoming pre-checkout query.
+type PreCheckoutQuery struct {
+	// Unique query identifier
+	ID string `json:"id"`
+
+	// User who sent the query
+	From User `json:"from"`
+
+	// Three-letter ISO 4217 currency code
+	Currency string `json:"currency"`
+
+	// Total price in the smallest units of the currency (integer, not float/double). For example, for a price of US$ 1.45 pass amount = 145.
+	TotalAmount int `json:"total_amount"`
+
+	// Bot specified invoice payload
+	InvoicePayload string `json:"invoice_payload"`
+
+	// Optional. Identifier of the shipping option chosen by the user
+	ShippingOptionID string `json:"shipping_option_id"`
+
+	// Optional. Order info provided by the user
+	OrderInfo *OrderInfo `json:"order_info"`
+}
+
+// ChatMemberUpdated represents changes in the status of a chat member.
+type ChatMemberUpdated struct {
+	Chat          Chat            // Chat the user belongs to
+	From          User            // Performer of the action, which resulted in the change
+	Date          int             // Date the change was done in Unix time
+	OldChatMember ChatMember      // Previous information about the chat member
+	NewChatMember ChatMember      // New information about the chat member
+	InviteLink    *ChatInviteLink // Optional. Chat invite link, which was used by the user to join the chat; for joining by invite link events only.
+}
+
+func (c *ChatMemberUpdated) UnmarshalJSON(data []byte) error {
+	var updated struct {
+		Chat          Chat            `json:"chat"`
+		From          User            `json:"from"`
+		Date          int             `json:"date"`
+		OldChatMember json.RawMessage `json:"old_chat_member"`
+		NewChatMember json.RawMessage `json:"new_chat_member"`
+		InviteLink    *ChatInviteLink `json:"invite_link"`
+	}
+	err := json.Unmarshal(data, &updated)
+	if err != nil {
+		return err
+	}
+	c.Chat = updated.Chat
+	c.From = updated.From
+	c.Date = updated.Date
+	c.InviteLink = updated.InviteLink
+	c.OldChatMember, err = unmarshalChatMember(updated.OldChatMember)
+	if err != nil {
+		return err
+	}
+	c.NewChatMember, err = unmarshalChatMember(updated.NewChatMember)
+	return err
+}
+
+// ChatInviteLink represents an invite link for a chat.
+type ChatInviteLink struct {
+	// The invite link. If the link was created by another chat administrator, then the second part of the link will be replaced with “…”.
+	InviteLink string `json:"invite_link"`
+
+	// Creator of the link
+	Creator User `json:"creator"`
+
+	// True, if users joining the chat via the link need to be approved by chat administrators
+	CreatesJoinRequest bool `json:"creates_join_request"`
+
+	// True, if the link is primary
+	IsPrimary bool `json:"is_primary"`
+
+	// True, if the link is revoked
+	IsRevoked bool `json:"is_revoked"`
+
+	// Optional. Invite link name
+	Name string `json:"name"`
+
+	// Optional. Point in time (Unix timestamp) when the link will expire or has been expired
+	ExpireDate int `json:"expire_date"`
+
+	// Optional. Maximum number of users that can be members of the chat simultaneously after joining the chat via this invite link; 1-99999
+	MemberLimit int `json:"member_limit"`
+
+	// Optional. Number of pending join requests created using this link
+	PendingJoinRequestCount int `json:"pending_join_request_count"`
+}
+
+// ChatJoinRequest represents a join request sent to a chat.
+type ChatJoinRequest struct {
+	Chat       Chat            `json:"chat"`        // Chat to which the request was sent
+	From       User            `json:"from"`        // User that sent the join request
+	Date       int             `json:"date"`        // Date the request was sent in Unix time
+	Bio        string          `json:"bio"`         // Optional. Bio of the user.
+	InviteLink *ChatInviteLink `json:"invite_link"` // Optional. Chat invite link that was used by the user to send the join request
+}
+
+// MessageID represents a unique message identifier.
+type MessageID struct {
+	// Unique message identifier
+	MessageID int `json:"message_id"`
+}
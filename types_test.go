@@ -1,7 +1,10 @@
 package ted
 
 import (
+	"encoding/json"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestMessage_CommandAndArgs(t *testing.T) {
@@ -92,3 +95,291 @@ func TestMessage_CommandAndArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestMessage_UnmarshalJSON(t *testing.T) {
+	t.Run("photo with caption", func(t *testing.T) {
+		payload := `{
+  "message_id": 1021,
+  "from": {"id": 1234567, "is_bot": false, "first_name": "Jane", "username": "jane", "language_code": "en"},
+  "chat": {"id": 1234567, "first_name": "Jane", "username": "jane", "type": "private"},
+  "date": 1600000000,
+  "media_group_id": "12783201839102",
+  "photo": [
+    {"file_id": "AgACAgUAAxkBAAID_small", "file_unique_id": "AQADsmall", "file_size": 1234, "width": 90, "height": 67},
+    {"file_id": "AgACAgUAAxkBAAID_large", "file_unique_id": "AQADlarge", "file_size": 56789, "width": 1280, "height": 960}
+  ],
+  "caption": "Look /here",
+  "caption_entities": [{"offset": 5, "length": 5, "type": "bot_command"}],
+  "reply_markup": {"inline_keyboard": [[{"text": "Open", "url": "https://example.com"}]]}
+}`
+		var message Message
+		err := json.Unmarshal([]byte(payload), &message)
+		assert.NoError(t, err)
+		assert.Equal(t, "12783201839102", message.MediaGroupID)
+		assert.Len(t, message.Photo, 2)
+		assert.Equal(t, "AgACAgUAAxkBAAID_large", message.Photo[1].FileID)
+		assert.Equal(t, 1280, message.Photo[1].Width)
+		assert.Equal(t, "Look /here", message.Caption)
+		assert.Equal(t, []MessageEntity{{Type: "bot_command", Offset: 5, Length: 5}}, message.CaptionEntities)
+		assert.Equal(t, "https://example.com", message.ReplyMarkup.InlineKeyboard[0][0].URL)
+		assert.True(t, message.IsDirectInteraction())
+	})
+	t.Run("forwarded channel post", func(t *testing.T) {
+		payload := `{
+  "message_id": 1022,
+  "from": {"id": 1234567, "is_bot": false, "first_name": "Jane"},
+  "chat": {"id": 1234567, "type": "private"},
+  "date": 1600000100,
+  "forward_from_chat": {"id": -1001111111111, "title": "News", "username": "news", "type": "channel"},
+  "forward_from_message_id": 42,
+  "forward_signature": "Editor",
+  "forward_date": 1599990000,
+  "edit_date": 1600000200,
+  "text": "Breaking news"
+}`
+		var message Message
+		err := json.Unmarshal([]byte(payload), &message)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(-1001111111111), message.ForwardFromChat.ID)
+		assert.Equal(t, "channel", message.ForwardFromChat.Type)
+		assert.Equal(t, 42, message.ForwardFromMessageID)
+		assert.Equal(t, "Editor", message.ForwardSignature)
+		assert.Equal(t, 1599990000, message.ForwardDate)
+		assert.Equal(t, 1600000200, message.EditDate)
+	})
+	t.Run("document", func(t *testing.T) {
+		payload := `{
+  "message_id": 1023,
+  "sender_chat": {"id": -1002222222222, "title": "Group", "type": "supergroup"},
+  "chat": {"id": -1002222222222, "title": "Group", "type": "supergroup"},
+  "date": 1600000300,
+  "author_signature": "Admin",
+  "document": {
+    "file_name": "report.pdf",
+    "mime_type": "application/pdf",
+    "thumb": {"file_id": "AAMCBQADGQEAAgQBthumb", "file_unique_id": "AQADthumb", "file_size": 2000, "width": 320, "height": 240},
+    "file_id": "BQACAgUAAxkBAAIEAWdoc",
+    "file_unique_id": "AgADdoc",
+    "file_size": 123456
+  }
+}`
+		var message Message
+		err := json.Unmarshal([]byte(payload), &message)
+		assert.NoError(t, err)
+		assert.Equal(t, "Admin", message.AuthorSignature)
+		assert.Equal(t, "report.pdf", message.Document.FileName)
+		assert.Equal(t, "application/pdf", message.Document.MimeType)
+		assert.Equal(t, 320, message.Document.Thumb.Width)
+	})
+	t.Run("audio", func(t *testing.T) {
+		payload := `{
+  "message_id": 1024,
+  "from": {"id": 1234567, "is_bot": false, "first_name": "Jane"},
+  "chat": {"id": 1234567, "first_name": "Jane", "type": "private"},
+  "date": 1600000310,
+  "audio": {
+    "duration": 215,
+    "file_name": "song.mp3",
+    "mime_type": "audio/mpeg",
+    "title": "Song",
+    "performer": "Band",
+    "file_id": "CQACAgUAAxkBAAIEAmaudio",
+    "file_unique_id": "AgADaudio",
+    "file_size": 3456789
+  }
+}`
+		var message Message
+		err := json.Unmarshal([]byte(payload), &message)
+		assert.NoError(t, err)
+		assert.Equal(t, "Band", message.Audio.Performer)
+		assert.Equal(t, "Song", message.Audio.Title)
+		assert.Equal(t, 215, message.Audio.Duration)
+	})
+	t.Run("video", func(t *testing.T) {
+		payload := `{
+  "message_id": 1025,
+  "from": {"id": 1234567, "is_bot": false, "first_name": "Jane"},
+  "chat": {"id": 1234567, "first_name": "Jane", "type": "private"},
+  "date": 1600000320,
+  "video": {
+    "duration": 12,
+    "width": 1920,
+    "height": 1080,
+    "file_name": "clip.mp4",
+    "mime_type": "video/mp4",
+    "thumb": {"file_id": "AAMCBQADGQEAAgQDthumb", "file_unique_id": "AQADvthumb", "file_size": 9000, "width": 320, "height": 180},
+    "file_id": "BAACAgUAAxkBAAIEA2video",
+    "file_unique_id": "AgADvideo",
+    "file_size": 4567890
+  },
+  "caption": "Clip"
+}`
+		var message Message
+		err := json.Unmarshal([]byte(payload), &message)
+		assert.NoError(t, err)
+		assert.Equal(t, 1080, message.Video.Height)
+		assert.Equal(t, 12, message.Video.Duration)
+		assert.Equal(t, "Clip", message.Caption)
+	})
+	t.Run("voice", func(t *testing.T) {
+		payload := `{
+  "message_id": 1026,
+  "from": {"id": 1234567, "is_bot": false, "first_name": "Jane"},
+  "chat": {"id": 1234567, "first_name": "Jane", "type": "private"},
+  "date": 1600000330,
+  "voice": {"duration": 3, "mime_type": "audio/ogg", "file_id": "AwACAgUAAxkBAAIEBHvoice", "file_unique_id": "AgADvoice", "file_size": 8901}
+}`
+		var message Message
+		err := json.Unmarshal([]byte(payload), &message)
+		assert.NoError(t, err)
+		assert.Equal(t, "audio/ogg", message.Voice.MimeType)
+		assert.Equal(t, 3, message.Voice.Duration)
+	})
+	t.Run("animation", func(t *testing.T) {
+		// for backward compatibility, the animation is also sent as a
+		// document
+		payload := `{
+  "message_id": 1027,
+  "from": {"id": 1234567, "is_bot": false, "first_name": "Jane"},
+  "chat": {"id": 1234567, "first_name": "Jane", "type": "private"},
+  "date": 1600000340,
+  "animation": {"file_name": "funny.mp4", "mime_type": "video/mp4", "duration": 4, "width": 320, "height": 180, "file_id": "CgACAgUAAxkBAAIEBWanimation", "file_unique_id": "AgADanimation", "file_size": 99999},
+  "document": {"file_name": "funny.mp4", "mime_type": "video/mp4", "file_id": "CgACAgUAAxkBAAIEBWanimation", "file_unique_id": "AgADanimation", "file_size": 99999}
+}`
+		var message Message
+		err := json.Unmarshal([]byte(payload), &message)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, message.Animation.Duration)
+		assert.Equal(t, "funny.mp4", message.Animation.FileName)
+		assert.Equal(t, message.Animation.FileID, message.Document.FileID)
+	})
+	t.Run("sticker", func(t *testing.T) {
+		payload := `{
+  "message_id": 1028,
+  "from": {"id": 1234567, "is_bot": false, "first_name": "Jane"},
+  "chat": {"id": 1234567, "first_name": "Jane", "type": "private"},
+  "date": 1600000350,
+  "sticker": {"width": 512, "height": 512, "emoji": "😀", "set_name": "Smileys", "is_animated": true, "file_id": "CAACAgUAAxkBAAIEBnsticker", "file_unique_id": "AgADsticker", "file_size": 24680}
+}`
+		var message Message
+		err := json.Unmarshal([]byte(payload), &message)
+		assert.NoError(t, err)
+		assert.Equal(t, "😀", message.Sticker.Emoji)
+		assert.Equal(t, "Smileys", message.Sticker.SetName)
+		assert.True(t, message.Sticker.IsAnimated)
+	})
+	t.Run("contact", func(t *testing.T) {
+		payload := `{
+  "message_id": 1029,
+  "from": {"id": 1234567, "is_bot": false, "first_name": "Jane"},
+  "chat": {"id": 1234567, "first_name": "Jane", "type": "private"},
+  "date": 1600000400,
+  "contact": {"phone_number": "+6591234567", "first_name": "John", "last_name": "Doe", "user_id": 7654321}
+}`
+		var message Message
+		err := json.Unmarshal([]byte(payload), &message)
+		assert.NoError(t, err)
+		assert.Equal(t, "+6591234567", message.Contact.PhoneNumber)
+		assert.Equal(t, int64(7654321), message.Contact.UserID)
+	})
+	t.Run("dice", func(t *testing.T) {
+		payload := `{
+  "message_id": 1030,
+  "from": {"id": 1234567, "is_bot": false, "first_name": "Jane"},
+  "chat": {"id": 1234567, "first_name": "Jane", "type": "private"},
+  "date": 1600000410,
+  "dice": {"emoji": "🎲", "value": 6}
+}`
+		var message Message
+		err := json.Unmarshal([]byte(payload), &message)
+		assert.NoError(t, err)
+		assert.Equal(t, "🎲", message.Dice.Emoji)
+		assert.Equal(t, 6, message.Dice.Value)
+	})
+	t.Run("venue", func(t *testing.T) {
+		// for backward compatibility, the location of the venue is also
+		// sent as a location
+		payload := `{
+  "message_id": 1031,
+  "from": {"id": 1234567, "is_bot": false, "first_name": "Jane"},
+  "chat": {"id": 1234567, "first_name": "Jane", "type": "private"},
+  "date": 1600000420,
+  "location": {"latitude": 1.2834, "longitude": 103.8607},
+  "venue": {"location": {"latitude": 1.2834, "longitude": 103.8607}, "title": "Marina Bay Sands", "address": "10 Bayfront Ave", "foursquare_id": "4b05886bf964a520a0c922e3"}
+}`
+		var message Message
+		err := json.Unmarshal([]byte(payload), &message)
+		assert.NoError(t, err)
+		assert.Equal(t, "Marina Bay Sands", message.Venue.Title)
+		assert.Equal(t, "10 Bayfront Ave", message.Venue.Address)
+		assert.Equal(t, float32(103.8607), message.Venue.Location.Longitude)
+	})
+	t.Run("poll", func(t *testing.T) {
+		payload := `{
+  "message_id": 1032,
+  "from": {"id": 1234567, "is_bot": false, "first_name": "Jane"},
+  "chat": {"id": -1002222222222, "title": "Group", "type": "supergroup"},
+  "date": 1600000430,
+  "poll": {"id": "5120000000000000001", "question": "Lunch?", "options": [{"text": "Yes", "voter_count": 3}, {"text": "No", "voter_count": 1}], "total_voter_count": 4, "is_closed": false, "is_anonymous": false, "type": "regular", "allows_multiple_answers": false}
+}`
+		var message Message
+		err := json.Unmarshal([]byte(payload), &message)
+		assert.NoError(t, err)
+		assert.Equal(t, "Lunch?", message.Poll.Question)
+		assert.Equal(t, []PollOption{{Text: "Yes", VoterCount: 3}, {Text: "No", VoterCount: 1}}, message.Poll.Options)
+		assert.Equal(t, 4, message.Poll.TotalVoterCount)
+	})
+}
+
+func TestUpdate_Kind(t *testing.T) {
+	tests := []struct {
+		payload string
+		want    string
+	}{
+		{`{"update_id":1,"message":{"message_id":1}}`, UpdateMessage},
+		{`{"update_id":1,"edited_message":{"message_id":1,"edit_date":1600000000}}`, UpdateEditedMessage},
+		{`{"update_id":1,"channel_post":{"message_id":1}}`, UpdateChannelPost},
+		{`{"update_id":1,"edited_channel_post":{"message_id":1}}`, UpdateEditedChannelPost},
+		{`{"update_id":1,"inline_query":{"id":"1","query":"q"}}`, UpdateInlineQuery},
+		{`{"update_id":1,"chosen_inline_result":{"result_id":"1"}}`, UpdateChosenInlineResult},
+		{`{"update_id":1,"callback_query":{"id":"1","data":"d"}}`, UpdateCallbackQuery},
+		{`{"update_id":1,"shipping_query":{"id":"1","invoice_payload":"p","shipping_address":{"country_code":"SG","city":"Singapore"}}}`, UpdateShippingQuery},
+		{`{"update_id":1,"pre_checkout_query":{"id":"1","currency":"SGD","total_amount":145,"order_info":{"name":"Jane"}}}`, UpdatePreCheckoutQuery},
+		{`{"update_id":1,"poll":{"id":"1","question":"q","is_closed":true}}`, UpdatePoll},
+		{`{"update_id":1,"poll_answer":{"poll_id":"1","user":{"id":2},"option_ids":[0,2]}}`, UpdatePollAnswer},
+		{`{"update_id":1,"my_chat_member":{"chat":{"id":1},"old_chat_member":{"status":"member"},"new_chat_member":{"status":"kicked"}}}`, UpdateMyChatMember},
+		{`{"update_id":1,"chat_member":{"chat":{"id":1},"old_chat_member":{"status":"left"},"new_chat_member":{"status":"member"}}}`, UpdateChatMember},
+		{`{"update_id":1,"chat_join_request":{"chat":{"id":1},"from":{"id":2},"date":1600000000,"invite_link":{"invite_link":"https://t.me/+abc","creates_join_request":true}}}`, UpdateChatJoinRequest},
+		{`{"update_id":1}`, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			var update Update
+			err := json.Unmarshal([]byte(tt.payload), &update)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, update.Kind())
+		})
+	}
+}
+
+func TestUpdate_UnmarshalJSON_PollAnswer(t *testing.T) {
+	payload := `{"update_id":100,"poll_answer":{"poll_id":"5120000000000000001","user":{"id":1234567,"is_bot":false,"first_name":"Jane"},"option_ids":[1]}}`
+	var update Update
+	err := json.Unmarshal([]byte(payload), &update)
+	assert.NoError(t, err)
+	assert.Equal(t, "5120000000000000001", update.PollAnswer.PollID)
+	assert.Equal(t, "Jane", update.PollAnswer.User.FirstName)
+	assert.Equal(t, []int{1}, update.PollAnswer.OptionIDs)
+}
+
+func TestPoll_UnmarshalJSON_Quiz(t *testing.T) {
+	var poll Poll
+	err := json.Unmarshal([]byte(`{"id":"1","question":"2 + 2?","options":[{"text":"4","voter_count":1},{"text":"5","voter_count":0}],"total_voter_count":1,"is_closed":true,"is_anonymous":false,"type":"quiz","allows_multiple_answers":false,"correct_option_id":0,"explanation":"Basic arithmetic","close_date":1600000000}`), &poll)
+	assert.NoError(t, err)
+	assert.Equal(t, PollQuiz, poll.Type)
+	if assert.NotNil(t, poll.CorrectOptionID) {
+		assert.Equal(t, 0, *poll.CorrectOptionID)
+	}
+	assert.Equal(t, "Basic arithmetic", poll.Explanation)
+	assert.Equal(t, 1600000000, poll.CloseDate)
+}
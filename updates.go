@@ -0,0 +1,100 @@
+package ted
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+const (
+	minPollBackoff = 1 * time.Second
+	maxPollBackoff = 1 * time.Minute
+)
+
+// Poll receives updates using long polling and passes them to handler one at
+// a time, in order, until ctx is done.
+//
+// req is used for every call to getUpdates, with its Offset advanced past
+// each update once handler returns so that handled updates are confirmed on
+// the next call. If getUpdates fails because of a network error, a server
+// error or flood control, Poll waits before trying again, doubling the delay
+// after each consecutive failure up to a maximum of one minute. Other errors
+// from Telegram, such as an invalid token or a webhook being set, will not go
+// away by trying again and are returned. Updates which cannot be decoded are
+// skipped.
+//
+// Poll always returns a non-nil error. After ctx is done, the error will be
+// ctx.Err().
+func (b Bot) Poll(ctx context.Context, req GetUpdatesRequest, handler UpdateHandler) error {
+	backoff := minPollBackoff
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		res, err := b.DoContext(ctx, req)
+		if err != nil {
+			if permanent(err) {
+				return err
+			}
+			if !sleep(ctx, backoff) {
+				return ctx.Err()
+			}
+			backoff *= 2
+			if backoff > maxPollBackoff {
+				backoff = maxPollBackoff
+			}
+			continue
+		}
+		backoff = minPollBackoff
+		var updates []json.RawMessage
+		err = json.Unmarshal(res.Result, &updates)
+		if err != nil {
+			return err
+		}
+		for _, data := range updates {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			var update Update
+			err := json.Unmarshal(data, &update)
+			if err != nil {
+				// skip the update so that it is not received again
+				var id struct {
+					ID int `json:"update_id"`
+				}
+				if json.Unmarshal(data, &id) != nil {
+					return err
+				}
+				req.Offset = id.ID + 1
+				continue
+			}
+			handler.HandleUpdate(ctx, update)
+			req.Offset = update.ID + 1
+		}
+	}
+}
+
+// permanent reports whether err is an error from Telegram which will not go
+// away by making the same request again.
+func permanent(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode != 429 && apiErr.ErrorCode < 500
+}
+
+// Updates starts polling for updates in a new goroutine and returns a channel
+// on which they will be delivered. The channel is closed when ctx is done or
+// polling stops because of an error. See Poll for details.
+func (b Bot) Updates(ctx context.Context, req GetUpdatesRequest) <-chan Update {
+	updates := make(chan Update)
+	go func() {
+		defer close(updates)
+		_ = b.Poll(ctx, req, UpdateHandlerFunc(func(ctx context.Context, update Update) {
+			select {
+			case updates <- update:
+			case <-ctx.Done():
+			}
+		}))
+	}()
+	return updates
+}
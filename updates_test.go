@@ -0,0 +1,138 @@
+package ted
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBot_Poll(t *testing.T) {
+	t.Run("advances offset past handled updates", func(t *testing.T) {
+		client := &httpClient{
+			results: []result{
+				okResponse(`[{"update_id":1},{"update_id":2}]`),
+				okResponse(`[]`),
+				okResponse(`[{"update_id":3}]`),
+			},
+		}
+		bot := Bot{HTTPClient: client}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		var handled []int
+		err := bot.Poll(ctx, GetUpdatesRequest{Timeout: 30}, UpdateHandlerFunc(func(ctx context.Context, update Update) {
+			handled = append(handled, update.ID)
+			if update.ID == 3 {
+				cancel()
+			}
+		}))
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, []int{1, 2, 3}, handled)
+		var offsets []int
+		for _, req := range client.requests {
+			var body struct {
+				Offset  int `json:"offset"`
+				Timeout int `json:"timeout"`
+			}
+			assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			assert.Equal(t, 30, body.Timeout)
+			offsets = append(offsets, body.Offset)
+		}
+		assert.Equal(t, []int{0, 3, 3}, offsets)
+	})
+	t.Run("tries again after an error", func(t *testing.T) {
+		client := &httpClient{
+			results: []result{
+				{
+					res: &http.Response{Body: ioutil.NopCloser(strings.NewReader(`{"ok":false,"error_code":502,"description":"Bad Gateway"}`))},
+				},
+				okResponse(`[{"update_id":1}]`),
+			},
+		}
+		bot := Bot{HTTPClient: client}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		var handled []int
+		err := bot.Poll(ctx, GetUpdatesRequest{}, UpdateHandlerFunc(func(ctx context.Context, update Update) {
+			handled = append(handled, update.ID)
+			cancel()
+		}))
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, []int{1}, handled)
+	})
+	t.Run("returns errors which will not go away", func(t *testing.T) {
+		client := &httpClient{
+			results: []result{
+				{
+					res: &http.Response{Body: ioutil.NopCloser(strings.NewReader(`{"ok":false,"error_code":409,"description":"Conflict: can't use getUpdates method while webhook is active; use deleteWebhook to delete the webhook first"}`))},
+				},
+			},
+		}
+		bot := Bot{HTTPClient: client}
+		err := bot.Poll(context.Background(), GetUpdatesRequest{}, UpdateHandlerFunc(func(ctx context.Context, update Update) {
+			t.Error("unexpected update")
+		}))
+		var apiErr *APIError
+		if assert.True(t, errors.As(err, &apiErr)) {
+			assert.Equal(t, 409, apiErr.ErrorCode)
+		}
+		assert.Len(t, client.requests, 1)
+	})
+	t.Run("skips updates which cannot be decoded", func(t *testing.T) {
+		client := &httpClient{
+			results: []result{
+				okResponse(`[{"update_id":1},{"update_id":2,"message":{"message_id":"2"}}]`),
+				okResponse(`[{"update_id":3}]`),
+			},
+		}
+		bot := Bot{HTTPClient: client}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		var handled []int
+		err := bot.Poll(ctx, GetUpdatesRequest{}, UpdateHandlerFunc(func(ctx context.Context, update Update) {
+			handled = append(handled, update.ID)
+			if update.ID == 3 {
+				cancel()
+			}
+		}))
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, []int{1, 3}, handled)
+		var body struct {
+			Offset int `json:"offset"`
+		}
+		assert.NoError(t, json.NewDecoder(client.requests[1].Body).Decode(&body))
+		assert.Equal(t, 3, body.Offset)
+	})
+}
+
+func TestBot_Updates(t *testing.T) {
+	client := &httpClient{
+		results: []result{
+			okResponse(`[{"update_id":1},{"update_id":2}]`),
+		},
+	}
+	bot := Bot{HTTPClient: client}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates := bot.Updates(ctx, GetUpdatesRequest{})
+	assert.Equal(t, 1, (<-updates).ID)
+	assert.Equal(t, 2, (<-updates).ID)
+	cancel()
+	for range updates {
+	}
+}
+
+func TestGetUpdatesRequest_MarshalJSON(t *testing.T) {
+	JSON, err := json.Marshal(GetUpdatesRequest{
+		Offset:         10,
+		Timeout:        30,
+		AllowedUpdates: []string{},
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"offset":10,"timeout":30,"allowed_updates":[]}`, string(JSON))
+}
@@ -0,0 +1,156 @@
+package ted
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"reflect"
+	"sort"
+)
+
+// InputFile represents a file to be sent. It can be one of:
+//
+//	FileID
+//	FileURL
+//	FileReader
+type InputFile interface {
+	inputFile()
+}
+
+// FileID refers to a file which already exists on the Telegram servers.
+type FileID string
+
+func (f FileID) inputFile() {}
+
+// FileURL is a HTTP URL for Telegram to get a file from the Internet.
+type FileURL string
+
+func (f FileURL) inputFile() {}
+
+// FileReader uploads a new file using multipart/form-data. The contents of
+// the file are streamed from Reader as the request is being made, so
+// requests uploading files are never retried.
+type FileReader struct {
+	// Name of the file
+	Name string
+
+	// Reader supplies the contents of the file
+	Reader io.Reader
+}
+
+func (f FileReader) inputFile() {}
+
+// ErrNilReader is returned when a request uploads a FileReader without a
+// Reader.
+var ErrNilReader = errors.New("ted: file to upload has no reader")
+
+// doUpload makes a request to the Telegram Bot API which may upload files.
+// params contains the values of the request's parameters, with zero values
+// being omitted. If any parameters are FileReaders, the request is made with
+// a multipart/form-data body, otherwise it is made with a JSON body. No
+// request is made if any FileReaders have no Reader.
+func (b Bot) doUpload(ctx context.Context, method string, params map[string]interface{}) (Response, error) {
+	values := make(map[string]interface{})
+	files := make(map[string]FileReader)
+	for k, v := range params {
+		switch v := v.(type) {
+		case FileReader:
+			if v.Reader == nil {
+				return Response{}, fmt.Errorf("%w: %s", ErrNilReader, k)
+			}
+			files[k] = v
+		case FileID:
+			values[k] = string(v)
+		case FileURL:
+			values[k] = string(v)
+		default:
+			if v != nil && !reflect.ValueOf(v).IsZero() {
+				values[k] = v
+			}
+		}
+	}
+	if len(files) == 0 {
+		return b.doJSON(ctx, method, values)
+	}
+	return b.doMultipart(ctx, method, values, files)
+}
+
+// doMultipart makes a POST request to the Telegram Bot API with a
+// multipart/form-data body.
+func (b Bot) doMultipart(ctx context.Context, method string, values map[string]interface{}, files map[string]FileReader) (Response, error) {
+	u := b.Endpoint.methodURL(b.Token, method)
+	body, w := io.Pipe()
+	// closing body stops the goroutine writing it if the request fails
+	// before the whole body has been read
+	defer body.Close()
+	mw := multipart.NewWriter(w)
+	go func() {
+		w.CloseWithError(writeMultipart(mw, values, files))
+	}()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, body)
+	if err != nil {
+		return Response{}, err
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	return b.doReq(ctx, req)
+}
+
+func writeMultipart(mw *multipart.Writer, values map[string]interface{}, files map[string]FileReader) error {
+	for _, k := range sortedKeys(values) {
+		value, err := formValue(values[k])
+		if err != nil {
+			return err
+		}
+		err = mw.WriteField(k, value)
+		if err != nil {
+			return err
+		}
+	}
+	names := make([]string, 0, len(files))
+	for k := range files {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	for _, k := range names {
+		part, err := mw.CreateFormFile(k, files[k].Name)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(part, files[k].Reader)
+		if err != nil {
+			return err
+		}
+	}
+	return mw.Close()
+}
+
+// formValue returns the representation of v as a form value. Strings,
+// numbers and booleans are formatted as they are, and all other values are
+// serialised as JSON.
+func formValue(v interface{}) (string, error) {
+	switch reflect.ValueOf(v).Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return fmt.Sprint(v), nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,133 @@
+package ted
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// clientFunc is a HTTPClient which handles requests using a function.
+type clientFunc func(req *http.Request) (*http.Response, error)
+
+func (f clientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestSendPhotoRequest_Upload(t *testing.T) {
+	var values map[string]string
+	var file, filename string
+	bot := Bot{
+		Token: "TOKEN",
+		HTTPClient: clientFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "https://api.telegram.org/botTOKEN/sendPhoto", req.URL.String())
+			_, params, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+			assert.NoError(t, err)
+			values = make(map[string]string)
+			mr := multipart.NewReader(req.Body, params["boundary"])
+			for {
+				part, err := mr.NextPart()
+				if err != nil {
+					break
+				}
+				data, err := ioutil.ReadAll(part)
+				assert.NoError(t, err)
+				if part.FileName() != "" {
+					file, filename = string(data), part.FileName()
+				} else {
+					values[part.FormName()] = string(data)
+				}
+			}
+			return &http.Response{Body: ioutil.NopCloser(strings.NewReader(`{"ok":true}`))}, nil
+		}),
+	}
+	req := SendPhotoRequest{
+		ChatID: 123,
+		Photo: FileReader{
+			Name:   "photo.jpg",
+			Reader: strings.NewReader("contents"),
+		},
+		Caption: "Caption",
+		ReplyMarkup: InlineKeyboardMarkup{
+			InlineKeyboard: [][]InlineKeyboardButton{{{Text: "Button", CallbackData: "Data"}}},
+		},
+	}
+	_, err := bot.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"chat_id":      "123",
+		"caption":      "Caption",
+		"reply_markup": `{"inline_keyboard":[[{"text":"Button","callback_data":"Data"}]]}`,
+	}, values)
+	assert.Equal(t, "contents", file)
+	assert.Equal(t, "photo.jpg", filename)
+}
+
+func TestSendPhotoRequest_FileID(t *testing.T) {
+	var body map[string]interface{}
+	bot := Bot{
+		HTTPClient: clientFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+			assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			return &http.Response{Body: ioutil.NopCloser(strings.NewReader(`{"ok":true}`))}, nil
+		}),
+	}
+	_, err := bot.DoContext(context.Background(), SendPhotoRequest{
+		ChatID: "@channel",
+		Photo:  FileID("abc"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"chat_id": "@channel",
+		"photo":   "abc",
+	}, body)
+}
+
+func TestSetChatPhotoRequest(t *testing.T) {
+	var chatID, file string
+	bot := Bot{
+		Token: "TOKEN",
+		HTTPClient: clientFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "https://api.telegram.org/botTOKEN/setChatPhoto", req.URL.String())
+			assert.NoError(t, req.ParseMultipartForm(1<<20))
+			chatID = req.FormValue("chat_id")
+			f, _, err := req.FormFile("photo")
+			if assert.NoError(t, err) {
+				data, _ := ioutil.ReadAll(f)
+				file = string(data)
+			}
+			return &http.Response{Body: ioutil.NopCloser(strings.NewReader(`{"ok":true,"result":true}`))}, nil
+		}),
+	}
+	ok, err := bot.SetChatPhoto(context.Background(), SetChatPhotoRequest{
+		ChatID: -1001234,
+		Photo: FileReader{
+			Name:   "photo.jpg",
+			Reader: strings.NewReader("contents"),
+		},
+	})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "-1001234", chatID)
+	assert.Equal(t, "contents", file)
+}
+
+func TestSetChatPhotoRequest_NilReader(t *testing.T) {
+	bot := Bot{
+		Token: "TOKEN",
+		HTTPClient: clientFunc(func(req *http.Request) (*http.Response, error) {
+			t.Error("unexpected request")
+			return nil, errors.New("unexpected request")
+		}),
+	}
+	_, err := bot.Do(SetChatPhotoRequest{ChatID: 1})
+	assert.True(t, errors.Is(err, ErrNilReader))
+	assert.EqualError(t, err, "ted: file to upload has no reader: photo")
+}
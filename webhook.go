@@ -0,0 +1,134 @@
+package ted
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// secretTokenHeader is the header containing the secret token set with
+// SetWebhookRequest.SecretToken.
+const secretTokenHeader = "X-Telegram-Bot-Api-Secret-Token"
+
+// WebhookHandler is a http.Handler which decodes updates delivered to a
+// webhook set up with SetWebhookRequest and dispatches them to an
+// UpdateHandler.
+//
+// Updates are handled synchronously; Telegram will not deliver further
+// updates until the response for the current one is written.
+type WebhookHandler struct {
+	// Path, if not empty, is the only URL path on which updates will be
+	// accepted. Requests to other paths receive a 404 response.
+	Path string
+
+	// SecretToken, if not empty, must match the X-Telegram-Bot-Api-Secret-Token
+	// header of incoming requests, which is set using
+	// SetWebhookRequest.SecretToken. Requests with a missing or incorrect
+	// token receive a 401 response.
+	SecretToken string
+
+	// Handler is called with each update received. If both Handler and
+	// Reply are nil, requests receive a 500 response.
+	Handler UpdateHandler
+
+	// Reply, if not nil, is called with each update received in place of
+	// Handler. If it returns a non-nil Request, the request is made by
+	// writing it in the webhook response instead of calling the Bot API.
+	// This saves a round trip, but it is not possible to know whether such
+	// a request was successful or to receive its result. Requests which
+	// upload files cannot be made this way.
+	Reply func(ctx context.Context, update Update) Request
+}
+
+// errNoWebhookHandler is returned to Telegram when a WebhookHandler has
+// nowhere to dispatch updates to, so that they are delivered again once it
+// has been configured properly.
+var errNoWebhookHandler = errors.New("ted: WebhookHandler has neither a Handler nor a Reply function")
+
+func (h WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.Path != "" && r.URL.Path != h.Path {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if h.SecretToken != "" {
+		token := r.Header.Get(secretTokenHeader)
+		if subtle.ConstantTimeCompare([]byte(token), []byte(h.SecretToken)) != 1 {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+	}
+	if h.Handler == nil && h.Reply == nil {
+		http.Error(w, errNoWebhookHandler.Error(), http.StatusInternalServerError)
+		return
+	}
+	var update Update
+	err := json.NewDecoder(r.Body).Decode(&update)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if h.Reply == nil {
+		h.Handler.HandleUpdate(r.Context(), update)
+		return
+	}
+	request := h.Reply(r.Context(), update)
+	if request == nil {
+		return
+	}
+	body, err := webhookReply(request)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(body)
+}
+
+// webhookRecorder is a HTTPClient which captures a request to the Bot API
+// instead of making it.
+type webhookRecorder struct {
+	req *http.Request
+}
+
+func (w *webhookRecorder) Do(req *http.Request) (*http.Response, error) {
+	w.req = req
+	return &http.Response{Body: ioutil.NopCloser(strings.NewReader(`{"ok":true}`))}, nil
+}
+
+// webhookReply returns the body of a webhook response which makes request.
+func webhookReply(request Request) ([]byte, error) {
+	var recorder webhookRecorder
+	_, err := request.doWith(context.Background(), Bot{HTTPClient: &recorder})
+	if err != nil {
+		return nil, err
+	}
+	req := recorder.req
+	params := make(map[string]interface{})
+	switch req.Header.Get("Content-Type") {
+	case "":
+		for k := range req.URL.Query() {
+			params[k] = req.URL.Query().Get(k)
+		}
+	case "application/json":
+		decoder := json.NewDecoder(req.Body)
+		decoder.UseNumber()
+		err = decoder.Decode(&params)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("ted: request cannot be made in a webhook response")
+	}
+	params["method"] = path.Base(req.URL.Path)
+	return json.Marshal(params)
+}
@@ -0,0 +1,72 @@
+package ted
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookHandler_ServeHTTP(t *testing.T) {
+	t.Run("passes decoded update to handler", func(t *testing.T) {
+		var received Update
+		handler := WebhookHandler{
+			Handler: UpdateHandlerFunc(func(ctx context.Context, update Update) {
+				received = update
+			}),
+		}
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"update_id":1,"message":{"message_id":2,"text":"hi"}}`))
+		handler.ServeHTTP(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 1, received.ID)
+		assert.Equal(t, "hi", received.Message.Text)
+	})
+	t.Run("rejects requests to other paths", func(t *testing.T) {
+		handler := WebhookHandler{
+			Path: "/webhook",
+		}
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "/other", strings.NewReader(`{"update_id":1}`))
+		handler.ServeHTTP(w, r)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+	t.Run("rejects requests with incorrect secret token", func(t *testing.T) {
+		handler := WebhookHandler{
+			SecretToken: "secret",
+		}
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"update_id":1}`))
+		r.Header.Set("X-Telegram-Bot-Api-Secret-Token", "wrong")
+		handler.ServeHTTP(w, r)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+	t.Run("fails without a handler", func(t *testing.T) {
+		var handler WebhookHandler
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"update_id":1}`))
+		handler.ServeHTTP(w, r)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+	t.Run("writes reply in response body", func(t *testing.T) {
+		handler := WebhookHandler{
+			SecretToken: "secret",
+			Reply: func(ctx context.Context, update Update) Request {
+				return SendMessageRequest{
+					ChatID: update.Message.Chat.ID,
+					Text:   "Hello",
+				}
+			},
+		}
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"update_id":1,"message":{"message_id":2,"chat":{"id":123}}}`))
+		r.Header.Set("X-Telegram-Bot-Api-Secret-Token", "secret")
+		handler.ServeHTTP(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		assert.JSONEq(t, `{"method":"sendMessage","chat_id":123,"text":"Hello"}`, w.Body.String())
+	})
+}